@@ -0,0 +1,45 @@
+package main
+
+import (
+	"flag"
+	"log"
+	"os"
+
+	client "github.com/GandarfHSE/go-mafia/internal/app/admin"
+	"github.com/GandarfHSE/go-mafia/internal/utils/grpcutil"
+	"github.com/GandarfHSE/go-mafia/internal/utils/i18n"
+	"github.com/GandarfHSE/go-mafia/internal/utils/tlsconf"
+)
+
+func main() {
+	addr := flag.String("addr", ":8086", "admin server address")
+	token := flag.String("token", os.Getenv("MAFIA_ADMIN_TOKEN"), "admin credential")
+	useTLS := flag.Bool("tls", false, "connect to server over TLS")
+	ca := flag.String("ca", "", "custom CA to trust, system roots are used if empty")
+	cert := flag.String("cert", "", "client certificate, if server requires it")
+	key := flag.String("key", "", "client certificate key")
+	serverName := flag.String("server-name", "", "override server name for certificate verification")
+	lang := flag.String("lang", i18n.UserLang(), "language of output: ru or en")
+	flag.Usage = func() {
+		flag.CommandLine.Output().Write([]byte("Usage: admin [flags] <command> [args...]\n"))
+		flag.PrintDefaults()
+		client.Usage(flag.CommandLine.Output(), i18n.CreatePrinter(*lang))
+	}
+	flag.Parse()
+	tr := i18n.CreatePrinter(*lang)
+
+	creds, err := tlsconf.ClientCredentials(*useTLS || *ca != "" || *cert != "", *ca, *cert, *key, *serverName)
+	if err != nil {
+		log.Fatalf("Bad TLS config: %v", err)
+	}
+
+	cli, err := client.CreateAdminClient(*addr, *token, creds, os.Stdout, tr)
+	if err != nil {
+		log.Fatalf("Failed to connect to admin server at addr %v!", *addr)
+	}
+	err = cli.Run(flag.Args())
+	cli.Close()
+	if err != nil {
+		log.Fatal(tr.T("admin.error", grpcutil.ErrorText(tr, err)))
+	}
+}
@@ -1,17 +1,33 @@
 package main
 
 import (
+	"log"
+
+	"github.com/GandarfHSE/go-mafia/internal/app/client/config"
 	client "github.com/GandarfHSE/go-mafia/internal/app/client/lobby"
+	"github.com/GandarfHSE/go-mafia/internal/app/client/tui"
 )
 
 func main() {
+	cfg := config.Load()
+	if cfg.TUI {
+		app, err := tui.CreateApp(cfg)
+		if err != nil {
+			log.Fatal(err)
+		}
+		if err := app.Run(); err != nil {
+			log.Fatalf("Can't start full-screen UI: %v", err)
+		}
+		return
+	}
+
 	defer func() {
 		if recover() != nil {
 			// всё хорошо =)
 		}
 	}()
 
-	cli := client.CreateLobbyClient()
+	cli := client.CreateLobbyClient(cfg)
 	defer cli.Close()
 	cli.Run()
 }
@@ -0,0 +1,76 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"log/slog"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/GandarfHSE/go-mafia/internal/app/gateway"
+	"github.com/GandarfHSE/go-mafia/internal/utils/logger"
+	"github.com/GandarfHSE/go-mafia/internal/utils/tlsconf"
+)
+
+const (
+	stopTimeout time.Duration = 5 * time.Second
+)
+
+func main() {
+	listen := flag.String("listen", envOr("MAFIA_WEB_ADDR", ":8080"), "address to serve web client on")
+	addr := flag.String("addr", envOr("MAFIA_SERVER_ADDR", "localhost:8085"), "lobby server address")
+	queueSize := flag.Int("queue-size", 256, "undelivered messages kept per web client, oldest are dropped")
+	sessionTTL := flag.Duration("session-ttl", 5*time.Minute, "REST sessions without requests and event stream are closed after it")
+	useTLS := flag.Bool("tls", envOr("MAFIA_TLS", "") == "true", "connect to server over TLS")
+	ca := flag.String("ca", envOr("MAFIA_TLS_CA", ""), "custom CA to trust, system roots are used if empty")
+	serverName := flag.String("server-name", envOr("MAFIA_TLS_SERVER_NAME", ""), "override server name for certificate verification")
+	logLevel := flag.String("log-level", envOr("MAFIA_LOG_LEVEL", "info"), "log level: debug, info, warn or error")
+	flag.Parse()
+
+	if err := logger.Setup(*logLevel, "text"); err != nil {
+		slog.Error("Bad logger config", logger.Error, err)
+		os.Exit(1)
+	}
+
+	creds, err := tlsconf.ClientCredentials(*useTLS || *ca != "", *ca, "", "", *serverName)
+	if err != nil {
+		slog.Error("Bad TLS config", logger.Error, err)
+		os.Exit(1)
+	}
+
+	gw, err := gateway.CreateGateway(gateway.Options{Addr: *addr, Creds: creds, QueueSize: *queueSize, SessionTTL: *sessionTTL})
+	if err != nil {
+		slog.Error("Can't connect to lobby", "addr", *addr, logger.Error, err)
+		os.Exit(1)
+	}
+	defer gw.Close()
+
+	ctx, cancel := signal.NotifyContext(context.Background(), syscall.SIGTERM, os.Interrupt)
+	defer cancel()
+
+	srv := &http.Server{Addr: *listen, Handler: gw.Handler()}
+	go func() {
+		<-ctx.Done()
+		stopCtx, stopCancel := context.WithTimeout(context.Background(), stopTimeout)
+		defer stopCancel()
+		srv.Shutdown(stopCtx)
+	}()
+
+	slog.Info("Serving web client", "addr", *listen, "lobby", *addr)
+	if err := srv.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		slog.Error("Gateway error", logger.Error, err)
+		os.Exit(1)
+	}
+	slog.Info("Bye!")
+}
+
+func envOr(key string, def string) string {
+	if v, ok := os.LookupEnv(key); ok {
+		return v
+	}
+	return def
+}
@@ -0,0 +1,45 @@
+package main
+
+import (
+	"flag"
+	"log"
+	"os"
+	"time"
+
+	"github.com/GandarfHSE/go-mafia/internal/app/loadtest"
+	"github.com/GandarfHSE/go-mafia/internal/utils/i18n"
+	"github.com/GandarfHSE/go-mafia/internal/utils/tlsconf"
+)
+
+func main() {
+	addr := flag.String("addr", "localhost:8085", "lobby server address")
+	clients := flag.Int("clients", 100, "number of simulated clients, should be a multiple of lobby size")
+	rate := flag.Float64("rate", 50, "clients started per second, 0 starts all at once")
+	chat := flag.Int("chat", 3, "chat messages sent by every client in lobby and every day")
+	rpcTimeout := flag.Duration("rpc-timeout", 5*time.Second, "timeout of a single RPC")
+	gameTimeout := flag.Duration("game-timeout", 2*time.Minute, "time limit for a client to finish its game")
+	useTLS := flag.Bool("tls", false, "connect to server over TLS")
+	ca := flag.String("ca", "", "custom CA to trust, system roots are used if empty")
+	serverName := flag.String("server-name", "", "override server name for certificate verification")
+	lang := flag.String("lang", i18n.UserLang(), "language of the report: ru or en")
+	flag.Parse()
+
+	creds, err := tlsconf.ClientCredentials(*useTLS || *ca != "", *ca, "", "", *serverName)
+	if err != nil {
+		log.Fatalf("Bad TLS config: %v", err)
+	}
+
+	stats, err := loadtest.Run(loadtest.Config{
+		Addr:        *addr,
+		Creds:       creds,
+		Clients:     *clients,
+		Rate:        *rate,
+		Chat:        *chat,
+		RPCTimeout:  *rpcTimeout,
+		GameTimeout: *gameTimeout,
+	})
+	if err != nil {
+		log.Fatalf("Load test failed: %v", err)
+	}
+	stats.Print(os.Stdout, i18n.CreatePrinter(*lang))
+}
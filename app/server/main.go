@@ -2,36 +2,112 @@ package main
 
 import (
 	"context"
-	"log"
+	"errors"
+	"log/slog"
 	"net"
+	"net/http"
 	"os"
 	"os/signal"
 	"syscall"
+	"time"
 
+	admin "github.com/GandarfHSE/go-mafia/internal/app/server/admin"
+	"github.com/GandarfHSE/go-mafia/internal/app/server/config"
 	server "github.com/GandarfHSE/go-mafia/internal/app/server/lobby"
+	"github.com/GandarfHSE/go-mafia/internal/app/server/metrics"
 	"github.com/GandarfHSE/go-mafia/internal/proto"
+	"github.com/GandarfHSE/go-mafia/internal/utils/grpcutil"
+	"github.com/GandarfHSE/go-mafia/internal/utils/logger"
+	"github.com/GandarfHSE/go-mafia/internal/utils/tlsconf"
 	"google.golang.org/grpc"
 )
 
+const (
+	stopTimeout time.Duration = 5 * time.Second
+)
+
 func main() {
-	log.Printf("Hi!")
 	ctx, cancel := signal.NotifyContext(context.Background(), syscall.SIGTERM, os.Interrupt)
 	defer cancel()
 
-	// [TODO] Get server port from config
-	lis, err := net.Listen("tcp", ":8085")
+	cfg := config.Load()
+	if err := logger.Setup(cfg.LogLevel, cfg.LogFormat); err != nil {
+		slog.Error("Bad logger config", logger.Error, err)
+		os.Exit(1)
+	}
+	slog.Info("Hi!")
+
+	lis, err := net.Listen("tcp", cfg.LobbyAddr)
 	if err != nil {
-		log.Fatal("Failed to listen!")
+		slog.Error("Failed to listen", "addr", cfg.LobbyAddr, logger.Error, err)
+		os.Exit(1)
 	}
-	log.Printf("Listening on :8085....")
+	slog.Info("Listening", "addr", cfg.LobbyAddr)
 
-	lobbyServer := server.CreateLobbyServer()
-	defer lobbyServer.Close()
+	var metricsServer *http.Server
+	if cfg.MetricsAddr != "" {
+		slog.Info("Serving metrics", "addr", cfg.MetricsAddr)
+		metricsServer = metrics.CreateServer(cfg.MetricsAddr)
+		go func() {
+			if err := metricsServer.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+				slog.Error("Metrics server error", logger.Error, err)
+			}
+		}()
+	}
+
+	// Logging interceptors are added per server, so game servers can log their game id
+	grpcOpts := []grpc.ServerOption{
+		grpc.ChainUnaryInterceptor(metrics.UnaryServerInterceptor(), tlsconf.IdentityInterceptor()),
+		grpc.ChainStreamInterceptor(metrics.StreamServerInterceptor(), tlsconf.StreamIdentityInterceptor()),
+	}
+	if cfg.TLSCert != "" {
+		creds, err := tlsconf.ServerCredentials(cfg.TLSCert, cfg.TLSKey, cfg.TLSClientCA, cfg.TLSRequireClientCert)
+		if err != nil {
+			slog.Error("Bad TLS config", logger.Error, err)
+			os.Exit(1)
+		}
+		slog.Info("TLS is enabled", "mtls", cfg.TLSClientCA != "")
+		grpcOpts = append(grpcOpts, grpc.Creds(creds))
+	}
 
-	grpcServer := grpc.NewServer()
+	lobbyServer := server.CreateLobbyServer(cfg, grpcOpts...)
+
+	grpcServer := grpc.NewServer(append(grpcOpts, logger.ServerOptions()...)...)
 	proto.RegisterLobbyServer(grpcServer, lobbyServer)
-	log.Printf("Serving grpc server...")
+	slog.Info("Serving grpc server")
 	go grpcServer.Serve(lis)
 
+	var adminServer *grpc.Server
+	if cfg.AdminToken != "" {
+		adminLis, err := net.Listen("tcp", cfg.AdminAddr)
+		if err != nil {
+			slog.Error("Failed to listen admin addr", "addr", cfg.AdminAddr, logger.Error, err)
+			os.Exit(1)
+		}
+		slog.Info("Admin service listening", "addr", cfg.AdminAddr)
+
+		adminOpts := append(grpcOpts, logger.ServerOptions()...)
+		adminOpts = append(adminOpts, grpc.ChainUnaryInterceptor(admin.AuthInterceptor(cfg.AdminToken)))
+		adminServer = grpc.NewServer(adminOpts...)
+		proto.RegisterAdminServer(adminServer, admin.CreateAdminServer(lobbyServer))
+		go adminServer.Serve(adminLis)
+	} else {
+		slog.Warn("Admin token is not set, admin service is disabled")
+	}
+
 	<-ctx.Done()
+	slog.Info("Shutdown signal received")
+
+	lobbyServer.Shutdown(cfg.DrainTimeout)
+	grpcutil.GracefulStop(grpcServer, stopTimeout)
+	lobbyServer.Close()
+	if adminServer != nil {
+		grpcutil.GracefulStop(adminServer, stopTimeout)
+	}
+	if metricsServer != nil {
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), stopTimeout)
+		metricsServer.Shutdown(shutdownCtx)
+		cancel()
+	}
+	slog.Info("Bye!")
 }
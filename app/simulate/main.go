@@ -0,0 +1,47 @@
+package main
+
+import (
+	"flag"
+	"log"
+	"os"
+	"time"
+
+	"github.com/GandarfHSE/go-mafia/internal/app/simulate"
+	"github.com/GandarfHSE/go-mafia/internal/utils/i18n"
+)
+
+func main() {
+	games := flag.Int("games", 10000, "number of games to play")
+	players := flag.Int("players", 4, "number of players")
+	roles := flag.String("roles", "maf=1,com=1", "special roles with counts, other players are civilians")
+	town := flag.String("town", "smart", "strategy of civilians and commissar: random or smart")
+	mafia := flag.String("mafia", "smart", "strategy of mafia: random or smart")
+	seed := flag.Int64("seed", time.Now().UnixNano(), "random seed, the same seed gives the same results")
+	lang := flag.String("lang", i18n.UserLang(), "language of the report: ru or en")
+	flag.Parse()
+
+	roleSet, err := simulate.ParseRoles(*players, *roles)
+	if err != nil {
+		log.Fatalf("Bad roles: %v", err)
+	}
+	townStrategy, err := simulate.GetStrategy(*town)
+	if err != nil {
+		log.Fatal(err)
+	}
+	mafiaStrategy, err := simulate.GetStrategy(*mafia)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	report, err := simulate.Run(simulate.Config{
+		Games: *games,
+		Roles: roleSet,
+		Town:  townStrategy,
+		Mafia: mafiaStrategy,
+		Seed:  *seed,
+	})
+	if err != nil {
+		log.Fatalf("Simulation failed: %v", err)
+	}
+	report.Print(os.Stdout, i18n.CreatePrinter(*lang))
+}
@@ -0,0 +1,162 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/GandarfHSE/go-mafia/internal/proto"
+	"github.com/GandarfHSE/go-mafia/internal/utils/grpcutil"
+	"github.com/GandarfHSE/go-mafia/internal/utils/i18n"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+)
+
+type AdminClient struct {
+	client   proto.AdminClient
+	grpcConn *grpc.ClientConn
+	token    string
+	out      io.Writer
+	tr       *i18n.Printer
+}
+
+func CreateAdminClient(serverAddr string, token string, creds credentials.TransportCredentials, out io.Writer, tr *i18n.Printer) (*AdminClient, error) {
+	grpcConn, err := grpc.Dial(serverAddr, grpc.WithTransportCredentials(creds))
+	if err != nil {
+		return nil, err
+	}
+
+	return &AdminClient{
+		client:   proto.NewAdminClient(grpcConn),
+		grpcConn: grpcConn,
+		token:    token,
+		out:      out,
+		tr:       tr,
+	}, nil
+}
+
+func (c *AdminClient) Close() {
+	c.grpcConn.Close()
+}
+
+func (c *AdminClient) ctx() (context.Context, context.CancelFunc) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	return metadata.AppendToOutgoingContext(ctx, grpcutil.TokenHeader, c.token), cancel
+}
+
+func Usage(w io.Writer, tr *i18n.Printer) {
+	fmt.Fprint(w, tr.T("admin.usage"))
+}
+
+func (c *AdminClient) Run(args []string) error {
+	if len(args) == 0 {
+		Usage(c.out, c.tr)
+		return errors.New(c.tr.T("admin.no_command"))
+	}
+
+	ctx, cancel := c.ctx()
+	defer cancel()
+
+	cmd, args := args[0], args[1:]
+	switch cmd {
+	case "list":
+		return c.list(ctx)
+	case "inspect":
+		if len(args) < 1 {
+			return errors.New(c.tr.T("admin.need_game_id", cmd))
+		}
+		return c.inspect(ctx, args[0])
+	case "end":
+		if len(args) < 1 {
+			return errors.New(c.tr.T("admin.need_game_id", cmd))
+		}
+		_, err := c.client.EndGame(ctx, &proto.GameIdRequest{GameId: args[0]})
+		return err
+	case "pause", "resume":
+		if len(args) < 1 {
+			return errors.New(c.tr.T("admin.need_game_id", cmd))
+		}
+		_, err := c.client.PauseGame(ctx, &proto.PauseGameRequest{GameId: args[0], Paused: cmd == "pause"})
+		return err
+	case "kick", "ban":
+		if len(args) < 1 {
+			return errors.New(c.tr.T("admin.need_name", cmd))
+		}
+		_, err := c.client.Kick(ctx, &proto.KickRequest{Name: args[0], Ban: cmd == "ban"})
+		return err
+	case "foul":
+		if len(args) < 1 {
+			return errors.New(c.tr.T("admin.need_name", cmd))
+		}
+		_, err := c.client.Foul(ctx, &proto.FoulRequest{Name: args[0]})
+		return err
+	case "announce":
+		if len(args) < 1 {
+			return errors.New(c.tr.T("admin.need_msg", cmd))
+		}
+		_, err := c.client.Announce(ctx, &proto.AnnounceRequest{Msg: strings.Join(args, " ")})
+		return err
+	default:
+		Usage(c.out, c.tr)
+		return errors.New(c.tr.T("admin.unknown_command", cmd))
+	}
+}
+
+func (c *AdminClient) list(ctx context.Context) error {
+	resp, err := c.client.List(ctx, &proto.Empty{})
+	if err != nil {
+		return err
+	}
+
+	for i, l := range resp.Lobbies {
+		fmt.Fprintln(c.out, c.tr.T("admin.lobby", i+1, len(l.PlayerNames), l.Capacity, strings.Join(l.PlayerNames, ", ")))
+	}
+	if len(resp.Games) == 0 {
+		fmt.Fprintln(c.out, c.tr.T("admin.no_games"))
+		return nil
+	}
+	for _, g := range resp.Games {
+		paused := ""
+		if g.Paused {
+			paused = c.tr.T("admin.paused")
+		}
+		fmt.Fprintln(c.out, c.tr.T("admin.game", g.Id, g.Addr, paused, g.Alive, len(g.PlayerNames), strings.Join(g.PlayerNames, ", ")))
+	}
+	return nil
+}
+
+func (c *AdminClient) inspect(ctx context.Context, id string) error {
+	resp, err := c.client.InspectGame(ctx, &proto.GameIdRequest{GameId: id})
+	if err != nil {
+		return err
+	}
+
+	paused := ""
+	if resp.Paused {
+		paused = c.tr.T("admin.paused")
+	}
+	fmt.Fprintln(c.out, c.tr.T("admin.inspect", resp.Id, resp.Phase, paused))
+	for i, p := range resp.Players {
+		alive := c.tr.T("admin.alive")
+		if !p.Alive {
+			alive = c.tr.T("admin.dead")
+		}
+		vote := "-"
+		switch {
+		case p.Vote == -1:
+			vote = c.tr.T("admin.vote_skipped")
+		case p.Vote >= 0:
+			vote = fmt.Sprintf("#%v", p.Vote+1)
+		}
+		votes := 0
+		if i < len(resp.VotesCount) {
+			votes = int(resp.VotesCount[i])
+		}
+		fmt.Fprintln(c.out, c.tr.T("admin.player", i+1, p.Name, p.Addr, p.Role, alive, vote, votes, p.Fouls))
+	}
+	return nil
+}
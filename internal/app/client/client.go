@@ -0,0 +1,128 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+	"time"
+
+	"github.com/GandarfHSE/go-mafia/internal/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	ReconnectAttempts int           = 60
+	ReconnectDelay    time.Duration = time.Second
+)
+
+var ErrUnavailable = errors.New("game server is unavailable")
+
+// ResolveGameAddr adds lobby host to game addr.
+// Game server reports its addr without host, it is on the same host as lobby.
+func ResolveGameAddr(lobbyAddr string, gameAddr string) string {
+	host, port, err := net.SplitHostPort(gameAddr)
+	if err != nil || host != "" {
+		return gameAddr
+	}
+	lobbyHost, _, err := net.SplitHostPort(lobbyAddr)
+	if err != nil {
+		return gameAddr
+	}
+	return net.JoinHostPort(lobbyHost, port)
+}
+
+// Hooks are called from FollowEvents goroutine, only Event is required
+type Hooks struct {
+	// Event gets every event once and in order
+	Event func(e *proto.GameEvent)
+	// Lost is called when game server is unavailable, before reconnecting
+	Lost func()
+	// Reconnected gets state fetched right before resubscribing
+	Reconnected func(st *proto.GameStateResponse)
+	// Missed gets state fetched after a gap in events
+	Missed func(st *proto.GameStateResponse)
+}
+
+// FollowEvents receives game events until the game ends or ctx is done.
+// After game server restarts or drops a slow subscriber it resubscribes,
+// duplicated events are skipped and missed ones are restored from state.
+func FollowEvents(ctx context.Context, game proto.GameClient, pl *proto.Player, h Hooks) error {
+	str, err := game.SubscribeToGameEvent(ctx, &proto.SubscribeToGameRequest{Player: pl})
+	if err != nil {
+		return err
+	}
+
+	var lastSeq uint64
+	for {
+		e, err := str.Recv()
+		if ctx.Err() != nil {
+			return nil
+		}
+		// Stream is closed before game end only by server shutdown, game is restored after restart
+		lost := err == io.EOF || status.Code(err) == codes.Unavailable
+		if lost || status.Code(err) == codes.ResourceExhausted {
+			if lost && h.Lost != nil {
+				h.Lost()
+			}
+			var st *proto.GameStateResponse
+			str, st, err = resubscribe(ctx, game, pl)
+			if ctx.Err() != nil {
+				return nil
+			}
+			if err != nil {
+				return err
+			}
+			lastSeq = st.LastSeq
+			if h.Reconnected != nil {
+				h.Reconnected(st)
+			}
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		if e.Seq <= lastSeq {
+			// duplicated event
+			continue
+		}
+		if e.Seq != lastSeq+1 && h.Missed != nil {
+			if st, err := game.GetState(ctx, &proto.StateRequest{Player: pl}); err == nil {
+				h.Missed(st)
+			}
+		}
+		lastSeq = e.Seq
+		h.Event(e)
+		if e.Type == proto.EventType_EVENT_TYPE_GAME_END {
+			return nil
+		}
+	}
+}
+
+// resubscribe waits for restarted game server and subscribes to events again.
+// State is fetched before subscribing, so events sent in between are not lost.
+func resubscribe(ctx context.Context, game proto.GameClient, pl *proto.Player) (proto.Game_SubscribeToGameEventClient, *proto.GameStateResponse, error) {
+	for i := 0; i < ReconnectAttempts; i++ {
+		select {
+		case <-time.After(ReconnectDelay):
+		case <-ctx.Done():
+			return nil, nil, ctx.Err()
+		}
+		st, err := game.GetState(ctx, &proto.StateRequest{Player: pl})
+		if status.Code(err) == codes.PermissionDenied {
+			// player was kicked, there is nothing to wait for
+			return nil, nil, err
+		}
+		if err != nil {
+			continue
+		}
+		str, err := game.SubscribeToGameEvent(ctx, &proto.SubscribeToGameRequest{Player: pl})
+		if err != nil {
+			continue
+		}
+		return str, st, nil
+	}
+	return nil, nil, ErrUnavailable
+}
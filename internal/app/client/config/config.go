@@ -0,0 +1,54 @@
+package config
+
+import (
+	"flag"
+	"os"
+
+	"github.com/GandarfHSE/go-mafia/internal/utils/i18n"
+	"github.com/GandarfHSE/go-mafia/internal/utils/tlsconf"
+	"google.golang.org/grpc/credentials"
+)
+
+type Config struct {
+	ServerAddr string
+	Lang       string
+	TUI        bool
+
+	TLS        bool
+	CAFile     string
+	CertFile   string
+	KeyFile    string
+	ServerName string
+}
+
+// Flags take precedence over env, env takes precedence over defaults
+func Load() *Config {
+	cfg := &Config{}
+
+	flag.StringVar(&cfg.ServerAddr, "addr", envOr("MAFIA_SERVER_ADDR", ":8085"), "lobby server address")
+	flag.StringVar(&cfg.Lang, "lang", i18n.UserLang(), "language of messages: ru or en")
+	flag.BoolVar(&cfg.TUI, "tui", envOr("MAFIA_TUI", "") == "true", "full-screen terminal UI")
+	flag.BoolVar(&cfg.TLS, "tls", envOr("MAFIA_TLS", "") == "true", "connect to server over TLS")
+	flag.StringVar(&cfg.CAFile, "ca", envOr("MAFIA_TLS_CA", ""), "custom CA to trust, system roots are used if empty")
+	flag.StringVar(&cfg.CertFile, "cert", envOr("MAFIA_TLS_CERT", ""), "client certificate, its common name must match player name")
+	flag.StringVar(&cfg.KeyFile, "key", envOr("MAFIA_TLS_KEY", ""), "client certificate key")
+	flag.StringVar(&cfg.ServerName, "server-name", envOr("MAFIA_TLS_SERVER_NAME", ""), "override server name for certificate verification")
+	flag.Parse()
+
+	// Custom CA or client certificate make sense only over TLS
+	if cfg.CAFile != "" || cfg.CertFile != "" {
+		cfg.TLS = true
+	}
+	return cfg
+}
+
+func (cfg *Config) Credentials() (credentials.TransportCredentials, error) {
+	return tlsconf.ClientCredentials(cfg.TLS, cfg.CAFile, cfg.CertFile, cfg.KeyFile, cfg.ServerName)
+}
+
+func envOr(key string, def string) string {
+	if v, ok := os.LookupEnv(key); ok {
+		return v
+	}
+	return def
+}
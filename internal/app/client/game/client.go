@@ -3,17 +3,20 @@ package client
 import (
 	"bufio"
 	"context"
-	"io"
 	"log"
 	"net"
 	"os"
 	"strings"
+	"sync"
 
+	mafiaclient "github.com/GandarfHSE/go-mafia/internal/app/client"
 	"github.com/GandarfHSE/go-mafia/internal/proto"
+	"github.com/GandarfHSE/go-mafia/internal/utils/grpcutil"
+	"github.com/GandarfHSE/go-mafia/internal/utils/i18n"
 	"github.com/GandarfHSE/go-mafia/internal/utils/terminal"
 	"github.com/fatih/color"
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/credentials"
 )
 
 type GameClient struct {
@@ -23,19 +26,24 @@ type GameClient struct {
 
 	Player      *proto.Player
 	Wr          *color.Color
+	tr          *i18n.Printer
 	reader      *bufio.Reader
 	cmdChan     chan string
 	gameEndChan chan struct{}
 
-	CmdPack *GameCommandPack
+	// Commands and game status are updated by events goroutine and used by Run
+	cmdMu   sync.Mutex
+	cmdPack *GameCommandPack
+	alive   bool
+	phase   proto.Phase
+	dayNum  int32
 	Role    string
-	Alive   bool
-	Day     bool
+	roles   map[string]*proto.RoleInfo
 	lastCmd []string
 }
 
-func CreateGameClient(serverAddr string, Player *proto.Player) *GameClient {
-	grpcConn, err := grpc.Dial(serverAddr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+func CreateGameClient(serverAddr string, Player *proto.Player, creds credentials.TransportCredentials, tr *i18n.Printer) *GameClient {
+	grpcConn, err := grpc.Dial(serverAddr, grpc.WithTransportCredentials(creds))
 	if err != nil {
 		log.Fatalf("Failed to connect to server at addr %v!", serverAddr)
 	}
@@ -47,12 +55,14 @@ func CreateGameClient(serverAddr string, Player *proto.Player) *GameClient {
 		grpcConn:    grpcConn,
 		Player:      Player,
 		Wr:          color.New(color.FgHiRed, color.Italic, color.Bold),
+		tr:          tr,
 		reader:      bufio.NewReader(os.Stdin),
-		Alive:       true,
+		alive:       true,
 		cmdChan:     make(chan string),
 		gameEndChan: make(chan struct{}),
-		Day:         true,
+		phase:       proto.Phase_PHASE_DAY,
 		lastCmd:     make([]string, 0),
+		roles:       make(map[string]*proto.RoleInfo),
 	}
 }
 
@@ -64,89 +74,194 @@ func (c *GameClient) Close() {
 }
 
 func (c *GameClient) HandleGameEvents() {
-	str, err := c.Client.SubscribeToGameEvent(context.TODO(), &proto.SubscribeToGameRequest{Player: c.Player})
+	err := mafiaclient.FollowEvents(context.TODO(), c.Client, c.Player, mafiaclient.Hooks{
+		Event: c.handleEvent,
+		Lost: func() {
+			c.Wr.Printf("%v\n\n", c.tr.T("game.connection_lost"))
+		},
+		Reconnected: func(st *proto.GameStateResponse) {
+			c.applyState(st)
+			c.Wr.Printf("%v\n\n", c.tr.T("game.reconnected"))
+		},
+		Missed: func(st *proto.GameStateResponse) {
+			log.Printf("Missed game events, state is restored up to #%v", st.LastSeq)
+			c.applyState(st)
+		},
+	})
 	if err != nil {
-		log.Fatalf("Can't subscribe to game events: %v", err)
+		log.Fatalf("HandleGameEvents error: %v", err)
 	}
-	for {
-		e, err := str.Recv()
-		if err == io.EOF {
+}
+
+func (c *GameClient) handleEvent(e *proto.GameEvent) {
+	if e.Version != proto.EventSchemaVersion {
+		log.Printf("Unsupported event schema version %v, expected %v", e.Version, proto.EventSchemaVersion)
+	}
+
+	switch e.Type {
+	case proto.EventType_EVENT_TYPE_PHASE_CHANGED:
+		ev := e.GetPhaseChanged()
+		c.cmdMu.Lock()
+		c.dayNum = ev.Day
+		c.cmdMu.Unlock()
+		c.setPhase(ev.Phase)
+	case proto.EventType_EVENT_TYPE_PLAYER_KILLED:
+		c.Wr.Printf("%v\n\n", c.tr.T("game.killed", e.GetKilled().Player))
+	case proto.EventType_EVENT_TYPE_PLAYER_JAILED:
+		c.Wr.Printf("%v\n\n", c.tr.T("game.jailed", e.GetJailed().Player))
+	case proto.EventType_EVENT_TYPE_GAME_END:
+		ev := e.GetEnd()
+		c.Wr.Println(c.tr.T("game.over"))
+		if team := c.teamTitle(ev.Won); team != "" {
+			c.Wr.Println(c.tr.T("game.winner", team))
+		} else {
+			c.Wr.Println(c.tr.T("game.no_winner"))
+		}
+		c.Wr.Println(c.tr.T("game.roles"))
+		for i := range ev.PlayerNames {
+			c.Wr.Printf("#%v. %v - ", i+1, ev.PlayerNames[i])
+			c.PrintRole(ev.Roles[i])
+			c.Wr.Print("\n")
+		}
+		close(c.gameEndChan)
+	case proto.EventType_EVENT_TYPE_SERVER_SHUTDOWN:
+		drain := e.GetShutdown().DrainSeconds
+		if drain > 0 {
+			c.Wr.Printf("%v\n\n", c.tr.T("game.shutdown_drain", drain))
+		} else {
+			c.Wr.Printf("%v\n\n", c.tr.T("game.shutdown"))
+		}
+	case proto.EventType_EVENT_TYPE_CHECK_RESULT:
+		ev := e.GetCheckResult()
+		if key := SeekResult(ev); key != "" {
+			c.Wr.Printf("%v\n\n", c.tr.T("game."+key, ev.Pid+1))
 			break
 		}
-		if err != nil {
-			log.Fatalf("HandleGameEvents error: %v", err)
+		c.Wr.Print(c.tr.T("game.check_result", ev.Pid+1))
+		c.PrintRole(ev.Role)
+		c.Wr.Print("!\n\n")
+	case proto.EventType_EVENT_TYPE_VOTE_TURN:
+		ev := e.GetVoteTurn()
+		if ev.Player == c.Player.Name {
+			c.Wr.Printf("%v\n\n", c.tr.T("game.your_vote_turn"))
+			c.refreshActions()
+		} else {
+			c.Wr.Printf("%v\n\n", c.tr.T("game.vote_turn", ev.Player))
+		}
+	case proto.EventType_EVENT_TYPE_SPEECH_TURN:
+		ev := e.GetSpeechTurn()
+		if ev.Player == c.Player.Name {
+			c.Wr.Printf("%v\n\n", c.tr.T("game.your_speech"))
+		} else {
+			c.Wr.Printf("%v\n\n", c.tr.T("game.speech_turn", ev.Player))
 		}
+		// previous speaker can't nominate anymore
+		c.refreshActions()
+	case proto.EventType_EVENT_TYPE_YOU_DEAD:
+		c.cmdMu.Lock()
+		c.alive = false
+		c.cmdMu.Unlock()
+		c.Wr.Printf("%v\n\n", c.tr.T("game.you_dead"))
+		c.refreshActions()
+	default:
+		log.Printf("Unknown event type: %v", e.Type)
+	}
+}
 
-		switch e.Type {
-		case "day":
-			c.Day = !c.Day
-			if c.Alive {
-				if c.Day {
-					c.CmdPack = GetDayCommandPack()
-				} else {
-					switch c.Role {
-					case "maf":
-						c.CmdPack = GetNightMafiaCommandPack()
-						c.Wr.Println("Настало время для поиска жертвы! Используйте команду !kill для убийства игрока\n")
-					case "com":
-						c.CmdPack = GetNightComCommandPack()
-						c.Wr.Println("Настало время для поиска мафии! Используйте команду !check для проверки игрока\n")
-					case "civ":
-						c.CmdPack = GetNightCivCommandPack()
-						c.Wr.Println("Полная луна за окном навевает тревогу...\n")
-					default:
-						log.Fatalf("Unknown role %v", c.Role)
-					}
-				}
-			}
-		case "kill":
-			c.Wr.Printf("Тело игрока %v утром было найдено в канаве...\n\n", e.GetKilled().Player)
-		case "jail":
-			c.Wr.Printf("Игрок %v вздёрнут на площади!\n\n", e.GetJailed().Player)
-		case "end":
-			ev := e.GetEnd()
-			c.Wr.Println("Игра окончена!")
-			if ev.Won == "maf" {
-				c.Wr.Println("Победу одержала мафия!")
-			} else {
-				c.Wr.Println("Победу одержали мирные жители!")
-			}
-			c.Wr.Println("Распределение по ролям:")
-			for i := range ev.PlayerNames {
-				c.Wr.Printf("#%v. %v - ", i+1, ev.PlayerNames[i])
-				PrintRole(ev.Roles[i])
-				c.Wr.Print("\n")
-			}
-			close(c.gameEndChan)
-		case "dead":
-			c.Alive = false
-			c.Wr.Println("Вы мертвы! :(\n")
-			c.CmdPack = GetDeadCommandPack()
-		default:
-			log.Printf("Unknown event type: %v", e.Type)
+func (c *GameClient) applyState(st *proto.GameStateResponse) {
+	c.cmdMu.Lock()
+	c.dayNum = st.Day
+	c.alive = st.Alive
+	changed := c.phase != st.Phase
+	c.cmdMu.Unlock()
+	if changed {
+		c.setPhase(st.Phase)
+	} else {
+		c.refreshActions()
+	}
+}
+
+func (c *GameClient) setPhase(phase proto.Phase) {
+	c.cmdMu.Lock()
+	c.phase = phase
+	alive := c.alive
+	c.cmdMu.Unlock()
+	c.refreshActions()
+	if alive && phase == proto.Phase_PHASE_NIGHT {
+		if info, ok := c.roles[c.Role]; ok {
+			c.Wr.Printf("%v\n\n", c.tr.T(info.NightHint))
 		}
 	}
 }
 
+// refreshActions rebuilds command pack from actions server allows right now
+func (c *GameClient) refreshActions() {
+	resp, err := c.Client.AvailableActions(context.TODO(), &proto.StateRequest{Player: c.Player})
+	if err != nil {
+		log.Printf("Can't get available actions: %v", err)
+		return
+	}
+	c.setCommands(resp.Actions)
+}
+
+// perform sends action chosen by the player to the server and updates available commands
+func (c *GameClient) perform(action string, targets []int32) (*proto.PerformActionResponse, error) {
+	resp, err := c.Client.PerformAction(context.TODO(), &proto.PerformActionRequest{Player: c.Player, ActionId: action, Targets: targets})
+	if err != nil {
+		return nil, err
+	}
+	c.setCommands(resp.Actions)
+	return resp, nil
+}
+
+func (c *GameClient) setCommands(actions []*proto.ActionInfo) {
+	pack := CreateActionCommandPack(actions)
+	c.cmdMu.Lock()
+	c.cmdPack = pack
+	c.cmdMu.Unlock()
+}
+
+func (c *GameClient) commands() *GameCommandPack {
+	c.cmdMu.Lock()
+	defer c.cmdMu.Unlock()
+	return c.cmdPack
+}
+
+// status returns whether player is alive and the current phase
+func (c *GameClient) status() (bool, proto.Phase) {
+	c.cmdMu.Lock()
+	defer c.cmdMu.Unlock()
+	return c.alive, c.phase
+}
+
 func (c *GameClient) PrepareForGame() {
-	go c.HandleGameEvents()
+	roleList, err := c.Client.RoleList(context.TODO(), &proto.Empty{})
+	if err != nil {
+		log.Fatal("Can't get RoleList in PrepareForGame")
+	}
+	for _, info := range roleList.Roles {
+		c.roles[info.Id] = info
+	}
 
 	roleResp, err := c.Client.Role(context.TODO(), &proto.RoleRequest{Player: c.Player})
 	if err != nil {
 		log.Fatal("Can't get Role in PrepareForGame")
 	}
 	c.Role = roleResp.Role
-	c.Wr.Print("Игра начинается!\n\nВаша роль: ")
-	PrintRole(c.Role)
-	c.Wr.Print("!\n\n")
+	c.roles[c.Role] = roleResp.Info
+	c.refreshActions()
+	// events are queued by server, so nothing is lost before subscription
+	go c.HandleGameEvents()
 
-	c.CmdPack = GetDayCommandPack()
-	c.Wr.Print("Для списка доступных команд введите !help\n\n")
+	c.Wr.Printf("%v\n\n%v", c.tr.T("game.start"), c.tr.T("game.your_role"))
+	c.PrintRole(c.Role)
+	c.Wr.Print("!\n\n")
+	c.Wr.Printf("%v\n\n", c.tr.T("game.help_hint"))
 }
 
 func (c *GameClient) ReadCmd() (string, bool) {
 	go func() {
-		c.Wr.Print("Введите команду или сообщение в чат:\n")
+		c.Wr.Println(c.tr.T("game.prompt"))
 		txt, err := c.reader.ReadString('\n')
 		if err != nil {
 			log.Fatalf("GameClient::ReadCmd error: %v", err)
@@ -163,7 +278,7 @@ func (c *GameClient) ReadCmd() (string, bool) {
 }
 
 func (c *GameClient) Run() bool {
-	c.Wr.Println("Введите любую строку для продолжения...")
+	c.Wr.Println(c.tr.T("game.press_enter"))
 	c.reader.ReadLine()
 	terminal.ClearScreen()
 	c.PrepareForGame()
@@ -180,25 +295,27 @@ func (c *GameClient) Run() bool {
 		}
 		if cmd[0] == '!' {
 			c.lastCmd = strings.Split(cmd, " ")
-			command, err := c.CmdPack.GetCommand(c.lastCmd[0])
+			command, err := c.commands().GetCommand(c.lastCmd[0])
 			if err != nil {
-				c.Wr.Print("Неправильная команда! Введите !help для списка команд\n")
+				c.Wr.Println(c.tr.T("game.bad_command"))
 			} else {
 				command.Run(c)
 			}
 		} else {
-			if !c.Alive {
-				c.Wr.Print("Дуновение ветра с кладбища напомнило прохожему о вас...")
+			// server decides who has last words
+			alive, phase := c.status()
+			if !alive && phase != proto.Phase_PHASE_LAST_WORDS {
+				c.Wr.Print(c.tr.T("game.dead_chat"))
 				continue
 			}
-			if !c.Day {
-				c.Wr.Print("Вы попытались нарушить ночную тишину, но никто вас не услышал...\n")
+			if phase == proto.Phase_PHASE_NIGHT {
+				c.Wr.Println(c.tr.T("game.night_chat"))
 				continue
 			}
 
 			_, err := c.Client.SendMessage(context.TODO(), &proto.SendMessageRequest{Msg: cmd, Player: c.Player})
 			if err != nil {
-				log.Printf("Can't send msg, error: %v\n", err)
+				c.Wr.Println(c.tr.T("game.send_failed", grpcutil.ErrorText(c.tr, err)))
 			}
 		}
 
@@ -3,17 +3,21 @@ package client
 import (
 	"context"
 	"errors"
+	"fmt"
 	"log"
 	"strconv"
+	"strings"
 
 	"github.com/GandarfHSE/go-mafia/internal/proto"
+	"github.com/GandarfHSE/go-mafia/internal/utils/grpcutil"
+	"github.com/GandarfHSE/go-mafia/internal/utils/i18n"
 	"github.com/fatih/color"
 )
 
 type GameCommand interface {
 	Name() string
 	Args() string
-	Descr() string
+	Descr(tr *i18n.Printer) string
 	Run(c *GameClient)
 }
 
@@ -21,10 +25,10 @@ type GameCommandPack struct {
 	cmds []GameCommand
 }
 
-func (pack *GameCommandPack) PrintHelp(w *color.Color) {
-	w.Print("Список команд:\n")
+func (pack *GameCommandPack) PrintHelp(w *color.Color, tr *i18n.Printer) {
+	w.Println(tr.T("cmd.list_title"))
 	for _, cmd := range pack.cmds {
-		w.Printf("%v %v - %v\n", cmd.Name(), cmd.Args(), cmd.Descr())
+		w.Printf("%v %v - %v\n", cmd.Name(), cmd.Args(), cmd.Descr(tr))
 	}
 }
 
@@ -44,52 +48,24 @@ func CreateCommandPack(cmds []GameCommand) *GameCommandPack {
 	return cmdPack
 }
 
-func GetDayCommandPack() *GameCommandPack {
-	return CreateCommandPack([]GameCommand{
+// Commands which don't depend on the phase or role
+func baseCommands() []GameCommand {
+	return []GameCommand{
 		&GameCommandList{},
 		&GameCommandRole{},
 		&GameCommandExit{},
 		&GameCommandAlive{},
-		&GameCommandVote{},
-	})
-}
-
-func GetNightMafiaCommandPack() *GameCommandPack {
-	return CreateCommandPack([]GameCommand{
-		&GameCommandList{},
-		&GameCommandRole{},
-		&GameCommandExit{},
-		&GameCommandAlive{},
-		&GameCommandKill{},
-	})
-}
-
-func GetNightComCommandPack() *GameCommandPack {
-	return CreateCommandPack([]GameCommand{
-		&GameCommandList{},
-		&GameCommandRole{},
-		&GameCommandExit{},
-		&GameCommandAlive{},
-		&GameCommandCheck{},
-	})
-}
-
-func GetNightCivCommandPack() *GameCommandPack {
-	return CreateCommandPack([]GameCommand{
-		&GameCommandList{},
-		&GameCommandRole{},
-		&GameCommandExit{},
-		&GameCommandAlive{},
-	})
+		&GameCommandState{},
+	}
 }
 
-func GetDeadCommandPack() *GameCommandPack {
-	return CreateCommandPack([]GameCommand{
-		&GameCommandList{},
-		&GameCommandRole{},
-		&GameCommandExit{},
-		&GameCommandAlive{},
-	})
+// CreateActionCommandPack adds commands for actions advertised by server
+func CreateActionCommandPack(actions []*proto.ActionInfo) *GameCommandPack {
+	cmds := baseCommands()
+	for _, a := range actions {
+		cmds = append(cmds, &GameCommandAction{info: a})
+	}
+	return CreateCommandPack(cmds)
 }
 
 // ===================
@@ -106,12 +82,12 @@ func (cmd *GameCommandHelp) Args() string {
 	return ""
 }
 
-func (cmd *GameCommandHelp) Descr() string {
-	return "Вывести список команд"
+func (cmd *GameCommandHelp) Descr(tr *i18n.Printer) string {
+	return tr.T("cmd.help")
 }
 
 func (cmd *GameCommandHelp) Run(c *GameClient) {
-	cmd.pack.PrintHelp(c.Wr)
+	cmd.pack.PrintHelp(c.Wr, c.tr)
 }
 
 // ===================
@@ -127,8 +103,8 @@ func (cmd *GameCommandList) Args() string {
 	return ""
 }
 
-func (cmd *GameCommandList) Descr() string {
-	return "Вывести список всех игроков"
+func (cmd *GameCommandList) Descr(tr *i18n.Printer) string {
+	return tr.T("cmd.list")
 }
 
 func (cmd *GameCommandList) Run(c *GameClient) {
@@ -138,7 +114,7 @@ func (cmd *GameCommandList) Run(c *GameClient) {
 		return
 	}
 
-	c.Wr.Printf("Игроков в игре: [%v/4]\n", len(resp.PlayerNames))
+	c.Wr.Println(c.tr.T("game.players_count", len(resp.PlayerNames)))
 	for ind, name := range resp.PlayerNames {
 		c.Wr.Printf("%v. %v\n", ind+1, name)
 	}
@@ -157,8 +133,8 @@ func (cmd *GameCommandExit) Args() string {
 	return ""
 }
 
-func (cmd *GameCommandExit) Descr() string {
-	return "Выйти из игры"
+func (cmd *GameCommandExit) Descr(tr *i18n.Printer) string {
+	return tr.T("cmd.exit")
 }
 
 func (cmd *GameCommandExit) Run(c *GameClient) {
@@ -183,143 +159,217 @@ func (cmd *GameCommandRole) Args() string {
 	return ""
 }
 
-func (cmd *GameCommandRole) Descr() string {
-	return "Напомнить свою роль"
+func (cmd *GameCommandRole) Descr(tr *i18n.Printer) string {
+	return tr.T("cmd.role")
 }
 
 func (cmd *GameCommandRole) Run(c *GameClient) {
 	resp, err := c.Client.Role(context.TODO(), &proto.RoleRequest{Player: c.Player})
-	c.Wr.Print("Ваша роль: ")
-	PrintRole(resp.Role)
-	c.Wr.Print("!\n")
 	if err != nil {
-		log.Printf("Role err: %v\n", err)
+		c.Wr.Println(c.tr.T("cmd.role_failed", grpcutil.ErrorText(c.tr, err)))
 		return
 	}
+	c.Wr.Print(c.tr.T("game.your_role"))
+	c.PrintRole(resp.Role)
+	c.Wr.Print("!\n")
 }
 
 // ===================
 
-type GameCommandVote struct {
+// GameCommandAction runs action advertised by server, see AvailableActions
+type GameCommandAction struct {
+	info *proto.ActionInfo
 }
 
-func (cmd *GameCommandVote) Name() string {
-	return "!vote"
+func (cmd *GameCommandAction) Name() string {
+	return "!" + cmd.info.Id
 }
 
-func (cmd *GameCommandVote) Args() string {
-	return "<pid>"
+func (cmd *GameCommandAction) Args() string {
+	if cmd.info.Arg == proto.ArgType_ARG_TYPE_PLAYER && cmd.info.MaxTargets > 1 {
+		return "<pid...>"
+	}
+	if cmd.info.Arg == proto.ArgType_ARG_TYPE_PLAYER {
+		return "<pid>"
+	}
+	return ""
 }
 
-func (cmd *GameCommandVote) Descr() string {
-	return "Проголосовать за посадку игрока pid (pid = 0 означает пропуск голосования)"
+func (cmd *GameCommandAction) Descr(tr *i18n.Printer) string {
+	descr := tr.T(cmd.info.Title)
+	if cmd.info.Arg == proto.ArgType_ARG_TYPE_PLAYER {
+		pids := make([]string, 0)
+		for _, pid := range cmd.info.Targets {
+			pids = append(pids, strconv.Itoa(int(pid)+1))
+		}
+		descr += fmt.Sprintf(" pid (%v)", strings.Join(pids, ", "))
+	}
+	if cmd.info.MaxTargets > 1 {
+		descr += tr.T("cmd.max_targets", cmd.info.MaxTargets)
+	}
+	if cmd.info.CanSkip {
+		descr += tr.T("cmd.can_skip")
+	}
+	return descr
 }
 
-func (cmd *GameCommandVote) Run(c *GameClient) {
-	if len(c.lastCmd) < 2 {
-		c.Wr.Print("Слишком мало аргументов для команды kill!\n")
-		return
+func (cmd *GameCommandAction) Run(c *GameClient) {
+	targets := make([]int32, 0)
+	if cmd.info.Arg == proto.ArgType_ARG_TYPE_PLAYER {
+		if len(c.lastCmd) < 2 {
+			c.Wr.Println(c.tr.T("cmd.few_args", cmd.Name()))
+			return
+		}
+		args := c.lastCmd[1:2]
+		if cmd.info.MaxTargets > 1 {
+			args = c.lastCmd[1:]
+		}
+		for _, arg := range args {
+			pid, err := strconv.Atoi(arg)
+			if err != nil {
+				c.Wr.Println(c.tr.T("cmd.bad_pid", arg))
+				return
+			}
+			targets = append(targets, int32(pid-1))
+		}
 	}
 
-	pid, err := strconv.Atoi(c.lastCmd[1])
-	_, err = c.Client.Vote(context.TODO(), &proto.VoteRequest{Player: c.Player, Voting: int32(pid - 1)})
+	resp, err := c.perform(cmd.info.Id, targets)
 	if err != nil {
-		c.Wr.Printf("Произошла ошибка при обработке голосования: %v\n", err)
+		c.Wr.Println(c.tr.T("cmd.action_failed", grpcutil.ErrorText(c.tr, err)))
 		return
 	}
+	if resp.Confirmation != "" {
+		c.Wr.Println(c.tr.T(resp.Confirmation))
+	}
 }
 
 // ===================
 
-type GameCommandKill struct {
+type GameCommandAlive struct {
 }
 
-func (cmd *GameCommandKill) Name() string {
-	return "!kill"
+func (cmd *GameCommandAlive) Name() string {
+	return "!alive"
 }
 
-func (cmd *GameCommandKill) Args() string {
-	return "<pid>"
+func (cmd *GameCommandAlive) Args() string {
+	return ""
 }
 
-func (cmd *GameCommandKill) Descr() string {
-	return "Убить игрока pid"
+func (cmd *GameCommandAlive) Descr(tr *i18n.Printer) string {
+	return tr.T("cmd.alive")
 }
 
-func (cmd *GameCommandKill) Run(c *GameClient) {
-	if len(c.lastCmd) < 2 {
-		c.Wr.Print("Слишком мало аргументов для команды kill!\n")
-		return
-	}
-
-	pid, err := strconv.Atoi(c.lastCmd[1])
-	_, err = c.Client.Kill(context.TODO(), &proto.KillRequest{Player: c.Player, Killing: int32(pid - 1)})
+func (cmd *GameCommandAlive) Run(c *GameClient) {
+	resp, err := c.Client.AliveList(context.TODO(), &proto.Empty{})
 	if err != nil {
-		c.Wr.Printf("Произошла ошибка при убийстве игрока: %v\n", err)
+		c.Wr.Println(c.tr.T("cmd.alive_failed", grpcutil.ErrorText(c.tr, err)))
 		return
 	}
-	c.Wr.Printf("Кажется, игрок #%v не доживёт до утра...\n", pid)
+	c.Wr.Println(c.tr.T("game.alive"))
+	for i := range resp.PlayerNames {
+		c.Wr.Printf("%v. %v\n", resp.Pids[i]+1, resp.PlayerNames[i])
+	}
 }
 
 // ===================
 
-type GameCommandCheck struct {
+type GameCommandState struct {
 }
 
-func (cmd *GameCommandCheck) Name() string {
-	return "!check"
+func (cmd *GameCommandState) Name() string {
+	return "!state"
 }
 
-func (cmd *GameCommandCheck) Args() string {
-	return "<pid>"
+func (cmd *GameCommandState) Args() string {
+	return ""
 }
 
-func (cmd *GameCommandCheck) Descr() string {
-	return "Узнать роль игрока pid"
+func (cmd *GameCommandState) Descr(tr *i18n.Printer) string {
+	return tr.T("cmd.state")
 }
 
-func (cmd *GameCommandCheck) Run(c *GameClient) {
-	if len(c.lastCmd) < 2 {
-		c.Wr.Print("Слишком мало аргументов для команды check!\n")
-		return
-	}
-
-	pid, err := strconv.Atoi(c.lastCmd[1])
-	resp, err := c.Client.Check(context.TODO(), &proto.CheckRequest{Player: c.Player, Checking: int32(pid - 1)})
+func (cmd *GameCommandState) Run(c *GameClient) {
+	st, err := c.Client.GetState(context.TODO(), &proto.StateRequest{Player: c.Player})
 	if err != nil {
-		c.Wr.Printf("Произошла ошибка при проверке игрока: %v\n", err)
+		c.Wr.Println(c.tr.T("cmd.state_failed", grpcutil.ErrorText(c.tr, err)))
 		return
 	}
-	c.Wr.Printf("Прошерстив архивные документы, вы выяснили, что роль игрока #%v - ", pid)
-	PrintRole(resp.Role)
-	c.Wr.Print("!\n")
-}
-
-// ===================
-
-type GameCommandAlive struct {
-}
 
-func (cmd *GameCommandAlive) Name() string {
-	return "!alive"
-}
+	switch {
+	case st.Ended:
+		c.Wr.Println(c.tr.T("state.ended"))
+	case st.Phase == proto.Phase_PHASE_NIGHT:
+		c.Wr.Println(c.tr.T("state.night", st.Day))
+	case st.Phase == proto.Phase_PHASE_LAST_WORDS:
+		c.Wr.Println(c.tr.T("state.last_words", st.Day))
+	case st.Phase == proto.Phase_PHASE_VOTING:
+		c.Wr.Println(c.tr.T("state.voting", st.Day))
+	case st.Phase == proto.Phase_PHASE_MEETING:
+		c.Wr.Println(c.tr.T("state.meeting"))
+	default:
+		c.Wr.Println(c.tr.T("state.day", st.Day))
+	}
+	if st.Paused {
+		c.Wr.Println(c.tr.T("state.paused"))
+	}
 
-func (cmd *GameCommandAlive) Args() string {
-	return ""
-}
+	c.Wr.Println(c.tr.T("state.players"))
+	for _, seat := range st.Seats {
+		c.Wr.Printf("%v. %v", seat.Pid+1, seat.Name)
+		if seat.Pid == st.Pid {
+			c.Wr.Print(c.tr.T("state.you"))
+		}
+		if !seat.Alive {
+			c.Wr.Print(c.tr.T("state.dead"))
+		} else if !seat.Connected {
+			c.Wr.Print(c.tr.T("state.disconnected"))
+		}
+		if seat.Role != "" {
+			c.Wr.Print(" - ")
+			c.PrintRole(seat.Role)
+		}
+		if nominated(st, seat.Pid) {
+			c.Wr.Print(c.tr.T("state.nominee"))
+		}
+		if seat.Pid == st.Voter {
+			c.Wr.Print(c.tr.T("state.voter"))
+		}
+		if seat.Pid == st.Speaker {
+			c.Wr.Print(c.tr.T("state.speaker"))
+		}
+		if seat.Fouls > 0 {
+			c.Wr.Print(c.tr.T("state.fouls", seat.Fouls))
+		}
+		if len(st.Votes) > int(seat.Pid) {
+			switch v := st.Votes[seat.Pid]; v {
+			case -2:
+			case -1:
+				c.Wr.Print(c.tr.T("state.no_vote"))
+			default:
+				c.Wr.Print(c.tr.T("state.vote", v+1))
+			}
+		}
+		c.Wr.Print("\n")
+	}
 
-func (cmd *GameCommandAlive) Descr() string {
-	return "Вывести список живых игроков"
+	for _, check := range st.Checks {
+		if key := SeekResult(check); key != "" {
+			c.Wr.Println(c.tr.T("state."+key, check.Day, check.Pid+1))
+			continue
+		}
+		c.Wr.Print(c.tr.T("state.check", check.Day, check.Pid+1))
+		c.PrintRole(check.Role)
+		c.Wr.Print("\n")
+	}
 }
 
-func (cmd *GameCommandAlive) Run(c *GameClient) {
-	resp, err := c.Client.AliveList(context.TODO(), &proto.Empty{})
-	if err != nil {
-		c.Wr.Printf("Произошла ошибка при получении списка живых игроков: %v\n", err)
-		return
-	}
-	c.Wr.Print("Оставшиеся игроки:\n")
-	for i := range resp.PlayerNames {
-		c.Wr.Printf("%v. %v\n", resp.Pids[i]+1, resp.PlayerNames[i])
+func nominated(st *proto.GameStateResponse, pid int32) bool {
+	for _, p := range st.Nominees {
+		if p == pid {
+			return true
+		}
 	}
+	return false
 }
@@ -3,22 +3,51 @@ package client
 import (
 	"log"
 
+	"github.com/GandarfHSE/go-mafia/internal/proto"
 	"github.com/fatih/color"
 )
 
-func PrintRole(role string) {
-	var wr *color.Color
-	switch role {
-	case "civ":
-		wr = color.New(color.FgHiGreen, color.Bold)
-		wr.Print("Мирный житель")
-	case "maf":
-		wr = color.New(color.FgHiBlack, color.BgHiRed, color.Bold)
-		wr.Print("Мафия")
-	case "com":
-		wr = color.New(color.FgHiCyan, color.Bold)
-		wr.Print("Комиссар")
-	default:
+var roleColors = map[string]*color.Color{
+	"green":   color.New(color.FgHiGreen, color.Bold),
+	"red":     color.New(color.FgHiBlack, color.BgHiRed, color.Bold),
+	"cyan":    color.New(color.FgHiCyan, color.Bold),
+	"yellow":  color.New(color.FgHiYellow, color.Bold),
+	"blue":    color.New(color.FgHiBlue, color.Bold),
+	"magenta": color.New(color.FgHiMagenta, color.Bold),
+}
+
+// PrintRole renders role using metadata from the server
+func (c *GameClient) PrintRole(role string) {
+	info, ok := c.roles[role]
+	if !ok {
 		log.Printf("Unknown role: %v", role)
+		c.Wr.Print(role)
+		return
+	}
+	wr, ok := roleColors[info.Color]
+	if !ok {
+		wr = color.New(color.Bold)
+	}
+	wr.Print(c.tr.T(info.Title))
+}
+
+// SeekResult returns message key suffix for the result of seeking, empty for other checks
+func SeekResult(check *proto.CheckResult) string {
+	switch {
+	case check.Action != "seek":
+		return ""
+	case check.Role != "":
+		return "seek_found"
+	}
+	return "seek_missed"
+}
+
+// teamTitle returns empty string for unknown team
+func (c *GameClient) teamTitle(team string) string {
+	for _, info := range c.roles {
+		if info.Team == team {
+			return c.tr.T(info.TeamTitle)
+		}
 	}
+	return ""
 }
@@ -11,12 +11,20 @@ import (
 	"strings"
 	"time"
 
+	mafiaclient "github.com/GandarfHSE/go-mafia/internal/app/client"
+	"github.com/GandarfHSE/go-mafia/internal/app/client/config"
 	client "github.com/GandarfHSE/go-mafia/internal/app/client/game"
 	"github.com/GandarfHSE/go-mafia/internal/proto"
+	"github.com/GandarfHSE/go-mafia/internal/utils/grpcutil"
+	"github.com/GandarfHSE/go-mafia/internal/utils/i18n"
+	"github.com/GandarfHSE/go-mafia/internal/utils/player"
 	"github.com/GandarfHSE/go-mafia/internal/utils/terminal"
+	"github.com/GandarfHSE/go-mafia/internal/utils/tlsconf"
 	"github.com/fatih/color"
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/status"
 )
 
 type LobbyClient struct {
@@ -26,73 +34,95 @@ type LobbyClient struct {
 
 	player proto.Player
 	w      *color.Color
+	tr     *i18n.Printer
 	reader *bufio.Reader
 
-	gameClient *client.GameClient
-	gameChan   chan struct{}
-	cmdChan    chan string
+	creds    credentials.TransportCredentials
+	certName string
+
+	gameClient   *client.GameClient
+	gameChan     chan struct{}
+	shutdownChan chan struct{}
+	cmdChan      chan string
 }
 
-func CreateLobbyClient() *LobbyClient {
-	// [TODO] Get server port from config
-	serverAddr := ":8085"
-	grpcConn, err := grpc.Dial(serverAddr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+func CreateLobbyClient(cfg *config.Config) *LobbyClient {
+	creds, err := cfg.Credentials()
 	if err != nil {
-		log.Fatalf("Failed to connect to server at addr %v!", serverAddr)
+		log.Fatalf("Bad TLS config: %v", err)
+	}
+	certName := ""
+	if cfg.CertFile != "" {
+		certName, err = tlsconf.CertCommonName(cfg.CertFile, cfg.KeyFile)
+		if err != nil {
+			log.Fatalf("Bad client certificate: %v", err)
+		}
+	}
+
+	grpcConn, err := grpc.Dial(cfg.ServerAddr, grpc.WithTransportCredentials(creds))
+	if err != nil {
+		log.Fatalf("Failed to connect to server at addr %v!", cfg.ServerAddr)
 	}
 	grpcConn.Connect()
 	cli := proto.NewLobbyClient(grpcConn)
 
 	return &LobbyClient{
-		client:     cli,
-		grpcConn:   grpcConn,
-		player:     proto.Player{},
-		w:          color.New(color.FgHiRed, color.Italic, color.Bold),
-		gameClient: nil,
-		gameChan:   make(chan struct{}),
-		cmdChan:    make(chan string),
-		reader:     bufio.NewReader(os.Stdin),
+		client:       cli,
+		grpcConn:     grpcConn,
+		creds:        creds,
+		certName:     certName,
+		player:       proto.Player{},
+		w:            color.New(color.FgHiRed, color.Italic, color.Bold),
+		tr:           i18n.CreatePrinter(cfg.Lang),
+		gameClient:   nil,
+		gameChan:     make(chan struct{}),
+		shutdownChan: make(chan struct{}),
+		cmdChan:      make(chan string),
+		reader:       bufio.NewReader(os.Stdin),
 	}
 }
 
 func (c *LobbyClient) serveChat() {
-	var buf [2048]byte
+	var buf [8192]byte
 	for {
 		msgLen, _, err := c.chatConn.ReadFrom(buf[:])
 		if err != nil {
 			break
 		}
-		str := string(buf[0:msgLen])
-		splittedMsg := strings.Split(str, "##")
-
-		if len(splittedMsg) < 2 {
-			fmt.Printf("len(splittedMsg) < 2!")
+		from, msg, err := player.ParseChat(string(buf[0:msgLen]))
+		if err != nil {
+			log.Printf("Bad chat message: %v", err)
 			continue
 		}
 
 		writer := color.New(color.FgHiWhite)
-		switch splittedMsg[0] {
-		case "server":
+		switch from {
+		case player.ServerSender:
 			writer = color.New(color.FgWhite)
+			writer.Print("[server] ")
 		case c.player.Addr:
 			continue
 		}
-		writer.Println(splittedMsg[1])
+		writer.Println(c.tr.Render(msg))
 	}
 }
 
 func (c *LobbyClient) WaitForGame() {
-	resp, err := c.client.SubscribeToGame(context.TODO(), &proto.Empty{})
+	resp, err := c.client.SubscribeToGame(context.TODO(), &proto.SubscribeToGameRequest{Player: &c.player})
+	if status.Code(err) == codes.Unavailable {
+		close(c.shutdownChan)
+		return
+	}
 	if err != nil {
 		log.Fatal("Can't connect to game!")
 	}
 
-	c.gameClient = client.CreateGameClient(resp.GameAddr, &c.player)
+	c.gameClient = client.CreateGameClient(mafiaclient.ResolveGameAddr(c.grpcConn.Target(), resp.GameAddr), &c.player, c.creds, c.tr)
 	c.gameChan <- struct{}{}
 }
 
 func (c *LobbyClient) ConnectToLobby() {
-	c.w.Println("Подключаюсь к лобби...")
+	c.w.Println(c.tr.T("lobby.connecting"))
 
 	rnd := rand.New(rand.NewSource(time.Now().Unix()))
 	var err error
@@ -113,13 +143,14 @@ func (c *LobbyClient) ConnectToLobby() {
 		log.Fatal("Can't connect to chat!")
 	}
 
-	go c.WaitForGame()
 	_, err = c.client.Join(context.TODO(), &proto.JoinRequest{Player: &c.player})
 	if err != nil {
-		log.Fatalf("err in join, err = %v", err)
+		c.w.Println(c.tr.T("lobby.join_failed", grpcutil.ErrorText(c.tr, err)))
+		os.Exit(1)
 	}
+	go c.WaitForGame()
 
-	c.w.Print("Подключение произошло успешно!")
+	c.w.Print(c.tr.T("lobby.connected"))
 	fmt.Print("\n\n==================================\n\n")
 }
 
@@ -131,15 +162,19 @@ func (c *LobbyClient) Close() {
 }
 
 func (c *LobbyClient) Greet() {
-	c.w.Println("~ Добро пожаловать в консольное приложение Мафия! ~")
-	c.w.Print("Введите своё имя:\n> ")
-	fmt.Scan(&c.player.Name)
-	c.w.Printf("Здравствуй, %s!\n\n", c.player.Name)
+	c.w.Println(c.tr.T("lobby.welcome"))
+	if c.certName != "" {
+		c.player.Name = c.certName
+	} else {
+		c.w.Printf("%v\n> ", c.tr.T("lobby.ask_name"))
+		fmt.Scan(&c.player.Name)
+	}
+	c.w.Printf("%v\n\n", c.tr.T("lobby.hello", c.player.Name))
 }
 
 func (c *LobbyClient) ReadCmd() (string, bool) {
 	go func() {
-		c.w.Print("Введите команду или сообщение в чат:\n")
+		c.w.Println(c.tr.T("game.prompt"))
 		txt, err := c.reader.ReadString('\n')
 		if err != nil {
 			log.Fatalf("LobbyClient::ReadCmd error: %v", err)
@@ -152,6 +187,8 @@ func (c *LobbyClient) ReadCmd() (string, bool) {
 		return cmd, false
 	case <-c.gameChan:
 		return "", true
+	case <-c.shutdownChan:
+		return "", true
 	}
 }
 
@@ -163,6 +200,13 @@ func (c *LobbyClient) Run() {
 	for {
 		cmd, _ := c.ReadCmd()
 
+		select {
+		case <-c.shutdownChan:
+			c.w.Println(c.tr.T("lobby.shutdown"))
+			return
+		default:
+		}
+
 		if c.gameClient != nil {
 			f := c.gameClient.Run()
 			c.gameClient.Close()
@@ -180,10 +224,10 @@ func (c *LobbyClient) Run() {
 		// [TODO]: Make command pack
 		switch cmd {
 		case "!help":
-			c.w.Print("Список команд:\n" +
-				"!help - Вывести это сообщение\n" +
-				"!list - Вывести список всех игроков\n" +
-				"!exit - Выйти из игры\n")
+			c.w.Println(c.tr.T("cmd.list_title"))
+			c.w.Printf("!help - %v\n", c.tr.T("cmd.help"))
+			c.w.Printf("!list - %v\n", c.tr.T("cmd.list"))
+			c.w.Printf("!exit - %v\n", c.tr.T("cmd.exit"))
 		case "!list":
 			resp, err := c.client.MemberList(context.TODO(), &proto.Empty{})
 			if err != nil {
@@ -191,7 +235,7 @@ func (c *LobbyClient) Run() {
 				continue
 			}
 
-			c.w.Printf("Игроков в лобби: [%v/4]\n", len(resp.PlayerNames))
+			c.w.Println(c.tr.T("lobby.players_count", len(resp.PlayerNames)))
 			for ind, name := range resp.PlayerNames {
 				c.w.Printf("%v. %v\n", ind+1, name)
 			}
@@ -206,13 +250,13 @@ func (c *LobbyClient) Run() {
 				continue
 			}
 			if cmd[0] == '!' {
-				c.w.Print("Неправильная команда! Введите !help для списка команд\n\n")
+				c.w.Printf("%v\n\n", c.tr.T("game.bad_command"))
 				continue
 			}
 
 			_, err := c.client.SendMessage(context.TODO(), &proto.SendMessageRequest{Msg: cmd, Player: &c.player})
 			if err != nil {
-				log.Printf("Can't send msg, error: %v\n", err)
+				c.w.Println(c.tr.T("game.send_failed", grpcutil.ErrorText(c.tr, err)))
 				continue
 			}
 		}
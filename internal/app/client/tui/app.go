@@ -0,0 +1,393 @@
+package tui
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"math/rand"
+	"net"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/GandarfHSE/go-mafia/internal/app/client/config"
+	"github.com/GandarfHSE/go-mafia/internal/proto"
+	"github.com/GandarfHSE/go-mafia/internal/utils/grpcutil"
+	"github.com/GandarfHSE/go-mafia/internal/utils/i18n"
+	"github.com/GandarfHSE/go-mafia/internal/utils/player"
+	"github.com/GandarfHSE/go-mafia/internal/utils/terminal"
+	"github.com/GandarfHSE/go-mafia/internal/utils/tlsconf"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+)
+
+type stage int
+
+const (
+	stageName stage = iota
+	stageLobby
+	stageGame
+	stageEnded
+)
+
+// App is a full-screen client. Its state is owned by the Run goroutine,
+// network goroutines hand results over with post.
+type App struct {
+	tr       *i18n.Printer
+	creds    credentials.TransportCredentials
+	certName string
+
+	lobby     proto.LobbyClient
+	lobbyConn *grpc.ClientConn
+	gameConn  *grpc.ClientConn
+	chatConn  *net.UDPConn
+	player    proto.Player
+
+	screen  *screen
+	chat    *pane
+	log     *pane
+	input   editor
+	updates chan func()
+	quit    bool
+
+	stage   stage
+	members []string
+	game    gameView
+}
+
+func CreateApp(cfg *config.Config) (*App, error) {
+	creds, err := cfg.Credentials()
+	if err != nil {
+		return nil, fmt.Errorf("bad TLS config: %w", err)
+	}
+	certName := ""
+	if cfg.CertFile != "" {
+		certName, err = tlsconf.CertCommonName(cfg.CertFile, cfg.KeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("bad client certificate: %w", err)
+		}
+	}
+	conn, err := grpc.Dial(cfg.ServerAddr, grpc.WithTransportCredentials(creds))
+	if err != nil {
+		return nil, err
+	}
+
+	tr := i18n.CreatePrinter(cfg.Lang)
+	return &App{
+		tr:        tr,
+		creds:     creds,
+		certName:  certName,
+		lobby:     proto.NewLobbyClient(conn),
+		lobbyConn: conn,
+		screen:    createScreen(os.Stdout),
+		chat:      &pane{title: tr.T("tui.chat")},
+		log:       &pane{title: tr.T("tui.log")},
+		updates:   make(chan func(), 64),
+		game:      gameView{roles: make(map[string]*proto.RoleInfo)},
+	}, nil
+}
+
+func (a *App) Run() error {
+	restore, err := terminal.MakeRaw(int(os.Stdin.Fd()))
+	if err != nil {
+		return err
+	}
+	defer restore()
+	a.screen.enter()
+	defer a.screen.leave()
+
+	// log output would break the frame, so it goes to the event pane
+	log.SetFlags(0)
+	log.SetOutput(logWriter{a})
+	defer log.SetOutput(os.Stderr)
+
+	keys := make(chan key)
+	go readKeys(os.Stdin, keys)
+	resize := make(chan os.Signal, 1)
+	terminal.NotifyResize(resize)
+	tick := time.NewTicker(time.Second)
+	defer tick.Stop()
+
+	a.logf(styleBold, "%s", a.tr.T("lobby.welcome"))
+	if a.certName != "" {
+		a.join(a.certName)
+	} else {
+		a.logf(styleNone, "%s", a.tr.T("lobby.ask_name"))
+	}
+
+	for !a.quit {
+		a.draw()
+		select {
+		case k, ok := <-keys:
+			if !ok || k.kind == keyQuit {
+				a.exit()
+				continue
+			}
+			if text, ok := a.input.handle(k); ok {
+				a.submit(strings.Join(strings.Fields(text), " "))
+			}
+		case fn := <-a.updates:
+			fn()
+		case <-resize:
+		case <-tick.C:
+		}
+	}
+	a.close()
+	return nil
+}
+
+// post runs fn in the Run goroutine
+func (a *App) post(fn func()) {
+	a.updates <- fn
+}
+
+func (a *App) logf(style string, format string, args ...any) {
+	a.log.add(style, fmt.Sprintf(format, args...))
+}
+
+type logWriter struct {
+	a *App
+}
+
+func (w logWriter) Write(p []byte) (int, error) {
+	text := string(p)
+	// logs may be written from the Run goroutine too
+	go w.a.post(func() {
+		w.a.log.add(styleError, text)
+	})
+	return len(p), nil
+}
+
+func (a *App) draw() {
+	width, height, err := terminal.Size(int(os.Stdout.Fd()))
+	if err != nil {
+		width, height = 80, 24
+	}
+
+	v := &view{
+		header: a.header(),
+		chat:   a.chat,
+		log:    a.log,
+		hint:   a.tr.T("tui.hint"),
+		prompt: "> ",
+		input:  a.input.buf,
+		cursor: a.input.pos,
+	}
+	switch a.stage {
+	case stageName:
+		v.prompt = a.tr.T("tui.name_prompt")
+	case stageLobby:
+		v.seats = a.memberLines()
+	default:
+		v.seats = a.seatLines()
+	}
+	a.screen.draw(width, height, v)
+}
+
+func (a *App) header() string {
+	parts := []string{a.tr.T("tui.title")}
+	if a.player.Name != "" {
+		parts = append(parts, a.player.Name)
+	}
+	switch a.stage {
+	case stageLobby:
+		parts = append(parts, a.tr.T("tui.lobby"))
+	case stageGame, stageEnded:
+		parts = append(parts, a.gameHeader()...)
+	}
+	return strings.Join(parts, " · ")
+}
+
+func (a *App) memberLines() []line {
+	lines := []line{{text: a.tr.T("lobby.players_count", len(a.members)), style: styleBold}}
+	for i, name := range a.members {
+		lines = append(lines, line{text: fmt.Sprintf("%v. %v", i+1, name)})
+	}
+	return lines
+}
+
+// submit handles entered line: name, command or chat message
+func (a *App) submit(text string) {
+	if text == "" {
+		return
+	}
+	if a.stage == stageName {
+		a.join(text)
+		return
+	}
+	if text[0] != '!' {
+		a.say(text)
+		return
+	}
+
+	args := strings.Split(text, " ")
+	switch args[0] {
+	case "!help":
+		a.help()
+	case "!exit":
+		a.exit()
+	case "!list":
+		a.refreshMembers()
+	default:
+		if a.stage == stageGame && a.command(args) {
+			return
+		}
+		a.logf(styleError, "%s", a.tr.T("game.bad_command"))
+	}
+}
+
+func (a *App) help() {
+	a.logf(styleBold, "%s", a.tr.T("cmd.list_title"))
+	a.logf(styleNone, "!help - %v", a.tr.T("cmd.help"))
+	a.logf(styleNone, "!list - %v", a.tr.T("cmd.list"))
+	if a.stage == stageGame {
+		a.gameHelp()
+	}
+	a.logf(styleNone, "!exit - %v", a.tr.T("cmd.exit"))
+}
+
+func (a *App) join(name string) {
+	a.player.Name = name
+	if a.chatConn == nil {
+		if err := a.listenChat(); err != nil {
+			a.logf(styleError, "%v", err)
+			a.quit = true
+			return
+		}
+	}
+
+	a.stage = stageLobby
+	a.logf(styleNone, "%s", a.tr.T("lobby.connecting"))
+	pl := &a.player
+	go func() {
+		_, err := a.lobby.Join(context.TODO(), &proto.JoinRequest{Player: pl})
+		a.post(func() {
+			if err != nil {
+				// name may be taken, so let the player choose another one
+				a.logf(styleError, "%s", a.tr.T("lobby.join_failed", grpcutil.ErrorText(a.tr, err)))
+				a.stage = stageName
+				return
+			}
+			a.logf(styleNone, "%s", a.tr.T("lobby.connected"))
+			a.refreshMembers()
+			go a.waitForGame(&proto.Player{Name: pl.Name, Addr: pl.Addr})
+		})
+	}()
+}
+
+func (a *App) listenChat() error {
+	rnd := rand.New(rand.NewSource(time.Now().UnixNano()))
+	var err error
+	for i := 0; i < 10; i++ {
+		port := 2000 + rnd.Uint32()%2000
+		a.player.Addr = fmt.Sprintf(":%v", port)
+		a.chatConn, err = net.ListenUDP("udp", &net.UDPAddr{Port: int(port)})
+		if err == nil {
+			go a.readChat(a.chatConn)
+			return nil
+		}
+	}
+	return fmt.Errorf("can't listen for chat: %w", err)
+}
+
+func (a *App) readChat(conn *net.UDPConn) {
+	var buf [8192]byte
+	for {
+		n, _, err := conn.ReadFrom(buf[:])
+		if err != nil {
+			return
+		}
+		from, msg, err := player.ParseChat(string(buf[:n]))
+		if err != nil {
+			continue
+		}
+		a.post(func() {
+			if from == player.ServerSender {
+				a.chat.add(styleDim, "[server] "+a.tr.Render(msg))
+			} else {
+				a.chat.add(styleNone, a.tr.Render(msg))
+			}
+			// joins and leaves are announced in chat
+			if a.stage == stageLobby && msg.Key != "chat.message" {
+				a.refreshMembers()
+			}
+		})
+	}
+}
+
+func (a *App) refreshMembers() {
+	if a.stage == stageGame || a.stage == stageEnded {
+		a.refreshState()
+		return
+	}
+	go func() {
+		resp, err := a.lobby.MemberList(context.TODO(), &proto.Empty{})
+		if err != nil {
+			return
+		}
+		a.post(func() {
+			a.members = resp.PlayerNames
+		})
+	}()
+}
+
+func (a *App) say(text string) {
+	// server decides who has last words
+	lastWords := a.stage == stageGame && a.game.state.GetPhase() == proto.Phase_PHASE_LAST_WORDS
+	if a.stage == stageGame && !a.game.alive() && !lastWords {
+		a.logf(styleDim, "%s", a.tr.T("game.dead_chat"))
+		return
+	}
+	if a.stage == stageGame && a.game.state.GetPhase() == proto.Phase_PHASE_NIGHT {
+		a.logf(styleDim, "%s", a.tr.T("game.night_chat"))
+		return
+	}
+
+	req := &proto.SendMessageRequest{Msg: text, Player: &a.player}
+	gameStage := a.stage == stageGame
+	go func() {
+		var err error
+		if gameStage {
+			_, err = a.game.client.SendMessage(context.TODO(), req)
+		} else {
+			_, err = a.lobby.SendMessage(context.TODO(), req)
+		}
+		if err != nil {
+			a.post(func() {
+				a.logf(styleError, "%s", a.tr.T("game.send_failed", grpcutil.ErrorText(a.tr, err)))
+			})
+		}
+	}()
+}
+
+func (a *App) waitForGame(pl *proto.Player) {
+	resp, err := a.lobby.SubscribeToGame(context.TODO(), &proto.SubscribeToGameRequest{Player: pl})
+	a.post(func() {
+		if err != nil {
+			a.logf(styleError, "%s", a.tr.T("lobby.shutdown"))
+			return
+		}
+		a.startGame(resp.GameAddr)
+	})
+}
+
+func (a *App) exit() {
+	pl := &a.player
+	switch a.stage {
+	case stageLobby:
+		a.lobby.Exit(context.TODO(), &proto.ExitRequest{Player: pl})
+	case stageGame:
+		a.game.client.Exit(context.TODO(), &proto.ExitRequest{Player: pl})
+	}
+	a.quit = true
+}
+
+func (a *App) close() {
+	if a.gameConn != nil {
+		a.gameConn.Close()
+	}
+	if a.chatConn != nil {
+		a.chatConn.Close()
+	}
+	a.lobbyConn.Close()
+}
@@ -0,0 +1,454 @@
+package tui
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/GandarfHSE/go-mafia/internal/app/client"
+	gameclient "github.com/GandarfHSE/go-mafia/internal/app/client/game"
+	"github.com/GandarfHSE/go-mafia/internal/proto"
+	"github.com/GandarfHSE/go-mafia/internal/utils/grpcutil"
+	"google.golang.org/grpc"
+)
+
+// gameView is what client knows about the game, refreshed from server state after every event
+type gameView struct {
+	client     proto.GameClient
+	roles      map[string]*proto.RoleInfo
+	role       string
+	state      *proto.GameStateResponse
+	actions    []*proto.ActionInfo
+	phaseStart time.Time
+	deadline   time.Time
+}
+
+func (g *gameView) alive() bool {
+	return g.state == nil || g.state.Alive
+}
+
+func (a *App) startGame(gameAddr string) {
+	conn, err := grpc.Dial(client.ResolveGameAddr(a.lobbyConn.Target(), gameAddr), grpc.WithTransportCredentials(a.creds))
+	if err != nil {
+		a.logf(styleError, "%v", err)
+		return
+	}
+	a.gameConn = conn
+	a.game.client = proto.NewGameClient(conn)
+	a.game.phaseStart = time.Now()
+	a.stage = stageGame
+	a.logf(styleBold, "%s", a.tr.T("game.start"))
+
+	cli := a.game.client
+	pl := &a.player
+	go func() {
+		roleList, err := cli.RoleList(context.TODO(), &proto.Empty{})
+		if err != nil {
+			a.post(func() { a.logf(styleError, "%v", grpcutil.ErrorText(a.tr, err)) })
+			return
+		}
+		role, err := cli.Role(context.TODO(), &proto.RoleRequest{Player: pl})
+		if err != nil {
+			a.post(func() { a.logf(styleError, "%v", grpcutil.ErrorText(a.tr, err)) })
+			return
+		}
+		a.post(func() {
+			for _, info := range roleList.Roles {
+				a.game.roles[info.Id] = info
+			}
+			a.game.role = role.Role
+			a.game.roles[role.Role] = role.Info
+			a.log.add(a.roleStyle(role.Role), a.tr.T("game.your_role")+a.roleTitle(role.Role))
+			a.refreshState()
+		})
+		a.events(cli)
+	}()
+}
+
+// events receives game events until the game ends, resubscribing after server restarts
+func (a *App) events(cli proto.GameClient) {
+	err := client.FollowEvents(context.TODO(), cli, &a.player, client.Hooks{
+		Event: func(e *proto.GameEvent) {
+			a.post(func() { a.handleEvent(e) })
+		},
+		Lost: func() {
+			a.post(func() { a.logf(styleError, "%s", a.tr.T("game.connection_lost")) })
+		},
+		Reconnected: func(st *proto.GameStateResponse) {
+			a.post(func() {
+				a.applyState(st)
+				a.logf(styleNone, "%s", a.tr.T("game.reconnected"))
+			})
+		},
+	})
+	if err != nil {
+		a.post(func() { a.logf(styleError, "%v", grpcutil.ErrorText(a.tr, err)) })
+	}
+}
+
+func (a *App) handleEvent(e *proto.GameEvent) {
+	switch e.Type {
+	case proto.EventType_EVENT_TYPE_PHASE_CHANGED:
+		ev := e.GetPhaseChanged()
+		a.game.phaseStart = time.Now()
+		a.game.deadline = time.Time{}
+		if ev.Deadline != nil {
+			a.game.deadline = ev.Deadline.AsTime()
+		}
+		switch ev.Phase {
+		case proto.Phase_PHASE_NIGHT:
+			a.logf(styleBold, "%s", a.tr.T("state.night", ev.Day))
+			if info, ok := a.game.roles[a.game.role]; ok && a.game.alive() {
+				a.logf(styleNone, "%s", a.tr.T(info.NightHint))
+			}
+		case proto.Phase_PHASE_LAST_WORDS:
+			a.logf(styleBold, "%s", a.tr.T("state.last_words", ev.Day))
+		case proto.Phase_PHASE_VOTING:
+			a.logf(styleBold, "%s", a.tr.T("state.voting", ev.Day))
+		case proto.Phase_PHASE_MEETING:
+			a.logf(styleBold, "%s", a.tr.T("state.meeting"))
+		default:
+			a.logf(styleBold, "%s", a.tr.T("state.day", ev.Day))
+		}
+	case proto.EventType_EVENT_TYPE_PLAYER_KILLED:
+		a.logf(styleNone, "%s", a.tr.T("game.killed", e.GetKilled().Player))
+	case proto.EventType_EVENT_TYPE_PLAYER_JAILED:
+		a.logf(styleNone, "%s", a.tr.T("game.jailed", e.GetJailed().Player))
+	case proto.EventType_EVENT_TYPE_CHECK_RESULT:
+		ev := e.GetCheckResult()
+		if key := gameclient.SeekResult(ev); key != "" {
+			a.logf(styleNone, "%s", a.tr.T("game."+key, ev.Pid+1))
+			break
+		}
+		a.log.add(a.roleStyle(ev.Role), a.tr.T("game.check_result", ev.Pid+1)+a.roleTitle(ev.Role))
+	case proto.EventType_EVENT_TYPE_VOTE_TURN:
+		ev := e.GetVoteTurn()
+		if a.game.state != nil && ev.Pid == a.game.state.Pid {
+			a.logf(styleBold, "%s", a.tr.T("game.your_vote_turn"))
+		} else {
+			a.logf(styleNone, "%s", a.tr.T("game.vote_turn", ev.Player))
+		}
+	case proto.EventType_EVENT_TYPE_SPEECH_TURN:
+		ev := e.GetSpeechTurn()
+		// every speech has its own time limit
+		a.game.phaseStart = time.Now()
+		a.game.deadline = time.Time{}
+		if ev.Deadline != nil {
+			a.game.deadline = ev.Deadline.AsTime()
+		}
+		if a.game.state != nil && ev.Pid == a.game.state.Pid {
+			a.logf(styleBold, "%s", a.tr.T("game.your_speech"))
+		} else {
+			a.logf(styleNone, "%s", a.tr.T("game.speech_turn", ev.Player))
+		}
+	case proto.EventType_EVENT_TYPE_YOU_DEAD:
+		a.logf(styleError, "%s", a.tr.T("game.you_dead"))
+		if a.game.state != nil {
+			a.game.state.Alive = false
+		}
+	case proto.EventType_EVENT_TYPE_SERVER_SHUTDOWN:
+		if drain := e.GetShutdown().DrainSeconds; drain > 0 {
+			a.logf(styleError, "%s", a.tr.T("game.shutdown_drain", drain))
+		} else {
+			a.logf(styleError, "%s", a.tr.T("game.shutdown"))
+		}
+	case proto.EventType_EVENT_TYPE_GAME_END:
+		a.endGame(e.GetEnd())
+		return
+	}
+	a.refreshState()
+}
+
+func (a *App) endGame(ev *proto.GameEnd) {
+	a.stage = stageEnded
+	a.game.actions = nil
+	a.logf(styleBold, "%s", a.tr.T("game.over"))
+	if team := a.teamTitle(ev.Won); team != "" {
+		a.logf(styleBold, "%s", a.tr.T("game.winner", team))
+	} else {
+		a.logf(styleBold, "%s", a.tr.T("game.no_winner"))
+	}
+	a.logf(styleNone, "%s", a.tr.T("game.roles"))
+	for i := range ev.PlayerNames {
+		a.log.add(a.roleStyle(ev.Roles[i]), fmt.Sprintf("#%v. %v - %v", i+1, ev.PlayerNames[i], a.roleTitle(ev.Roles[i])))
+	}
+	a.logf(styleDim, "%s", a.tr.T("tui.game_over"))
+	// reveal all roles in the seat list
+	if a.game.state != nil {
+		for i, seat := range a.game.state.Seats {
+			if i < len(ev.Roles) {
+				seat.Role = ev.Roles[i]
+			}
+		}
+		a.game.state.Ended = true
+	}
+}
+
+// refreshState fetches seats, votes and available actions
+func (a *App) refreshState() {
+	if a.stage != stageGame {
+		return
+	}
+	cli := a.game.client
+	pl := &a.player
+	go func() {
+		st, err := cli.GetState(context.TODO(), &proto.StateRequest{Player: pl})
+		if err != nil {
+			return
+		}
+		actions, err := cli.AvailableActions(context.TODO(), &proto.StateRequest{Player: pl})
+		a.post(func() {
+			a.applyState(st)
+			if err == nil {
+				a.game.actions = actions.Actions
+			}
+		})
+	}()
+}
+
+func (a *App) applyState(st *proto.GameStateResponse) {
+	if a.stage != stageGame {
+		return
+	}
+	if a.game.state != nil && (a.game.state.Phase != st.Phase || a.game.state.Day != st.Day) {
+		a.game.phaseStart = time.Now()
+	}
+	a.game.deadline = time.Time{}
+	if st.Deadline != nil {
+		a.game.deadline = st.Deadline.AsTime()
+	}
+	a.game.state = st
+}
+
+func (a *App) gameHelp() {
+	a.logf(styleNone, "!role - %v", a.tr.T("cmd.role"))
+	a.logf(styleNone, "!state - %v", a.tr.T("cmd.state"))
+	for _, info := range a.game.actions {
+		a.logf(styleNone, "%v - %v", actionUsage(info), a.actionDescr(info))
+	}
+}
+
+// command runs game command, returns false for unknown ones
+func (a *App) command(args []string) bool {
+	switch args[0] {
+	case "!role":
+		a.log.add(a.roleStyle(a.game.role), a.tr.T("game.your_role")+a.roleTitle(a.game.role))
+		return true
+	case "!state":
+		a.refreshState()
+		return true
+	}
+
+	for _, info := range a.game.actions {
+		if args[0] == "!"+info.Id {
+			a.perform(info, args)
+			return true
+		}
+	}
+	return false
+}
+
+func (a *App) perform(info *proto.ActionInfo, args []string) {
+	targets := make([]int32, 0)
+	if info.Arg == proto.ArgType_ARG_TYPE_PLAYER {
+		if len(args) < 2 {
+			a.logf(styleError, "%s", a.tr.T("cmd.few_args", args[0]))
+			return
+		}
+		pids := args[1:2]
+		if info.MaxTargets > 1 {
+			pids = args[1:]
+		}
+		for _, arg := range pids {
+			pid, err := strconv.Atoi(arg)
+			if err != nil {
+				a.logf(styleError, "%s", a.tr.T("cmd.bad_pid", arg))
+				return
+			}
+			targets = append(targets, int32(pid-1))
+		}
+	}
+
+	cli := a.game.client
+	req := &proto.PerformActionRequest{Player: &a.player, ActionId: info.Id, Targets: targets}
+	go func() {
+		resp, err := cli.PerformAction(context.TODO(), req)
+		a.post(func() {
+			if err != nil {
+				a.logf(styleError, "%s", a.tr.T("cmd.action_failed", grpcutil.ErrorText(a.tr, err)))
+				return
+			}
+			if resp.Confirmation != "" {
+				a.logf(styleNone, "%s", a.tr.T(resp.Confirmation))
+			}
+			a.game.actions = resp.Actions
+			a.refreshState()
+		})
+	}()
+}
+
+func actionUsage(info *proto.ActionInfo) string {
+	if info.Arg == proto.ArgType_ARG_TYPE_PLAYER && info.MaxTargets > 1 {
+		return "!" + info.Id + " <pid...>"
+	}
+	if info.Arg == proto.ArgType_ARG_TYPE_PLAYER {
+		return "!" + info.Id + " <pid>"
+	}
+	return "!" + info.Id
+}
+
+func (a *App) actionDescr(info *proto.ActionInfo) string {
+	descr := a.tr.T(info.Title)
+	if info.MaxTargets > 1 {
+		descr += a.tr.T("cmd.max_targets", info.MaxTargets)
+	}
+	if info.CanSkip {
+		descr += a.tr.T("cmd.can_skip")
+	}
+	return descr
+}
+
+func (a *App) gameHeader() []string {
+	st := a.game.state
+	if st == nil {
+		return nil
+	}
+	parts := make([]string, 0)
+	if st.Ended || a.stage == stageEnded {
+		return append(parts, a.tr.T("tui.ended"))
+	}
+	switch st.Phase {
+	case proto.Phase_PHASE_NIGHT:
+		parts = append(parts, a.tr.T("tui.night", st.Day))
+	case proto.Phase_PHASE_LAST_WORDS:
+		parts = append(parts, a.tr.T("tui.last_words"))
+	case proto.Phase_PHASE_VOTING:
+		parts = append(parts, a.tr.T("tui.voting"))
+	case proto.Phase_PHASE_MEETING:
+		parts = append(parts, a.tr.T("tui.meeting"))
+	default:
+		parts = append(parts, a.tr.T("tui.day", st.Day))
+	}
+	if !a.game.deadline.IsZero() {
+		left := time.Until(a.game.deadline)
+		if left < 0 {
+			left = 0
+		}
+		parts = append(parts, a.tr.T("tui.left", clock(left)))
+	} else {
+		parts = append(parts, clock(time.Since(a.game.phaseStart)))
+	}
+	if st.Paused {
+		parts = append(parts, a.tr.T("tui.paused"))
+	}
+	parts = append(parts, a.roleTitle(a.game.role))
+	if !st.Alive {
+		parts = append(parts, a.tr.T("tui.dead"))
+	}
+	return parts
+}
+
+func clock(d time.Duration) string {
+	sec := int(d.Seconds())
+	return fmt.Sprintf("%02d:%02d", sec/60, sec%60)
+}
+
+// seatLines shows seats with alive, nominee, turn, vote, foul and known role markers, then private checks and actions
+func (a *App) seatLines() []line {
+	st := a.game.state
+	if st == nil {
+		return nil
+	}
+	lines := []line{{text: a.tr.T("state.players"), style: styleBold}}
+	for _, seat := range st.Seats {
+		text := fmt.Sprintf("%v. %v", seat.Pid+1, seat.Name)
+		style := styleNone
+		if seat.Pid == st.Pid {
+			text += a.tr.T("state.you")
+			style = styleBold
+		}
+		if seat.Role != "" {
+			text += " " + a.roleTitle(seat.Role)
+			style = a.roleStyle(seat.Role)
+		}
+		if int(seat.Pid) < len(st.Votes) {
+			switch v := st.Votes[seat.Pid]; v {
+			case -2:
+			case -1:
+				text += " —"
+			default:
+				text += fmt.Sprintf(" →%v", v+1)
+			}
+		}
+		if int(seat.Pid) < len(st.VotesCount) && st.VotesCount[seat.Pid] > 0 {
+			text += fmt.Sprintf(" [%v]", st.VotesCount[seat.Pid])
+		}
+		for _, pid := range st.Nominees {
+			if pid == seat.Pid {
+				text += " ◆"
+			}
+		}
+		if seat.Pid == st.Voter || seat.Pid == st.Speaker {
+			text += " ◀"
+		}
+		if seat.Fouls > 0 {
+			text += fmt.Sprintf(" ⚠%v", seat.Fouls)
+		}
+		if !seat.Alive {
+			text += " ✝"
+			style = styleDim
+		} else if !seat.Connected {
+			text += " …"
+		}
+		lines = append(lines, line{text: text, style: style})
+	}
+
+	for _, check := range st.Checks {
+		if key := gameclient.SeekResult(check); key != "" {
+			lines = append(lines, line{text: a.tr.T("state."+key, check.Day, check.Pid+1)})
+			continue
+		}
+		lines = append(lines, line{text: a.tr.T("state.check", check.Day, check.Pid+1) + a.roleTitle(check.Role), style: a.roleStyle(check.Role)})
+	}
+
+	if len(a.game.actions) > 0 {
+		lines = append(lines, line{}, line{text: a.tr.T("tui.actions"), style: styleBold})
+		for _, info := range a.game.actions {
+			lines = append(lines, line{text: actionUsage(info)})
+			if info.Arg == proto.ArgType_ARG_TYPE_PLAYER {
+				pids := make([]string, 0)
+				for _, pid := range info.Targets {
+					pids = append(pids, strconv.Itoa(int(pid)+1))
+				}
+				lines = append(lines, line{text: "  pid: " + strings.Join(pids, ", "), style: styleDim})
+			}
+		}
+	}
+	return lines
+}
+
+func (a *App) roleTitle(role string) string {
+	if info, ok := a.game.roles[role]; ok {
+		return a.tr.T(info.Title)
+	}
+	return role
+}
+
+func (a *App) roleStyle(role string) string {
+	if info, ok := a.game.roles[role]; ok {
+		return roleStyles[info.Color]
+	}
+	return styleNone
+}
+
+// teamTitle returns empty string for unknown team
+func (a *App) teamTitle(team string) string {
+	for _, info := range a.game.roles {
+		if info.Team == team {
+			return a.tr.T(info.TeamTitle)
+		}
+	}
+	return ""
+}
@@ -0,0 +1,199 @@
+package tui
+
+import (
+	"io"
+	"unicode"
+	"unicode/utf8"
+)
+
+const maxHistory int = 100
+
+type keyKind int
+
+const (
+	keyRune keyKind = iota
+	keyEnter
+	keyBackspace
+	keyDelete
+	keyLeft
+	keyRight
+	keyUp
+	keyDown
+	keyHome
+	keyEnd
+	keyClear
+	keyQuit
+)
+
+type key struct {
+	kind keyKind
+	r    rune
+}
+
+var escapeKeys = map[string]keyKind{
+	"[A":  keyUp,
+	"[B":  keyDown,
+	"[C":  keyRight,
+	"[D":  keyLeft,
+	"[H":  keyHome,
+	"[F":  keyEnd,
+	"OH":  keyHome,
+	"OF":  keyEnd,
+	"[1~": keyHome,
+	"[4~": keyEnd,
+	"[3~": keyDelete,
+}
+
+// readKeys decodes raw terminal input until r is closed
+func readKeys(r io.Reader, keys chan<- key) {
+	defer close(keys)
+	buf := make([]byte, 256)
+	pending := make([]byte, 0)
+	for {
+		n, err := r.Read(buf)
+		if err != nil {
+			return
+		}
+		pending = append(pending, buf[:n]...)
+		var parsed []key
+		parsed, pending = parseKeys(pending)
+		for _, k := range parsed {
+			keys <- k
+		}
+	}
+}
+
+// parseKeys returns decoded keys and incomplete tail of input
+func parseKeys(in []byte) ([]key, []byte) {
+	res := make([]key, 0)
+	for len(in) > 0 {
+		b := in[0]
+		switch {
+		case b == 0x1b:
+			if len(in) == 1 {
+				// lone escape, ignored
+				in = in[1:]
+				continue
+			}
+			end := 1
+			for end < len(in) && end < 8 {
+				c := in[end]
+				end++
+				if end > 2 && (c >= 'A' && c <= 'Z' || c == '~') {
+					break
+				}
+			}
+			if kind, ok := escapeKeys[string(in[1:end])]; ok {
+				res = append(res, key{kind: kind})
+			}
+			in = in[end:]
+		case b == '\r' || b == '\n':
+			res = append(res, key{kind: keyEnter})
+			in = in[1:]
+		case b == 0x7f || b == 0x08:
+			res = append(res, key{kind: keyBackspace})
+			in = in[1:]
+		case b == 0x03 || b == 0x04:
+			res = append(res, key{kind: keyQuit})
+			in = in[1:]
+		case b == 0x01:
+			res = append(res, key{kind: keyHome})
+			in = in[1:]
+		case b == 0x05:
+			res = append(res, key{kind: keyEnd})
+			in = in[1:]
+		case b == 0x15:
+			res = append(res, key{kind: keyClear})
+			in = in[1:]
+		case b < 0x20:
+			in = in[1:]
+		default:
+			if !utf8.FullRune(in) {
+				return res, in
+			}
+			r, size := utf8.DecodeRune(in)
+			if r != utf8.RuneError && unicode.IsPrint(r) {
+				res = append(res, key{kind: keyRune, r: r})
+			}
+			in = in[size:]
+		}
+	}
+	return res, in
+}
+
+// editor is an input line with history of submitted lines
+type editor struct {
+	buf     []rune
+	pos     int
+	history []string
+	// position in history while browsing it, len(history) means the line being edited
+	hpos  int
+	draft []rune
+}
+
+// handle applies key and returns submitted line, if any
+func (e *editor) handle(k key) (string, bool) {
+	switch k.kind {
+	case keyRune:
+		e.buf = append(e.buf[:e.pos], append([]rune{k.r}, e.buf[e.pos:]...)...)
+		e.pos++
+	case keyBackspace:
+		if e.pos > 0 {
+			e.buf = append(e.buf[:e.pos-1], e.buf[e.pos:]...)
+			e.pos--
+		}
+	case keyDelete:
+		if e.pos < len(e.buf) {
+			e.buf = append(e.buf[:e.pos], e.buf[e.pos+1:]...)
+		}
+	case keyLeft:
+		if e.pos > 0 {
+			e.pos--
+		}
+	case keyRight:
+		if e.pos < len(e.buf) {
+			e.pos++
+		}
+	case keyHome:
+		e.pos = 0
+	case keyEnd:
+		e.pos = len(e.buf)
+	case keyClear:
+		e.set(nil)
+	case keyUp:
+		if e.hpos > 0 {
+			if e.hpos == len(e.history) {
+				e.draft = e.buf
+			}
+			e.hpos--
+			e.set([]rune(e.history[e.hpos]))
+		}
+	case keyDown:
+		if e.hpos < len(e.history) {
+			e.hpos++
+			if e.hpos == len(e.history) {
+				e.set(e.draft)
+			} else {
+				e.set([]rune(e.history[e.hpos]))
+			}
+		}
+	case keyEnter:
+		text := string(e.buf)
+		if text != "" && (len(e.history) == 0 || e.history[len(e.history)-1] != text) {
+			e.history = append(e.history, text)
+			if len(e.history) > maxHistory {
+				e.history = e.history[1:]
+			}
+		}
+		e.hpos = len(e.history)
+		e.draft = nil
+		e.set(nil)
+		return text, true
+	}
+	return "", false
+}
+
+func (e *editor) set(buf []rune) {
+	e.buf = append([]rune(nil), buf...)
+	e.pos = len(e.buf)
+}
@@ -0,0 +1,190 @@
+package tui
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+)
+
+const maxPaneLines int = 1000
+
+// SGR codes of line styles
+const (
+	styleNone   string = ""
+	styleDim    string = "2"
+	styleBold   string = "1"
+	styleError  string = "31"
+	styleHeader string = "7"
+)
+
+var roleStyles = map[string]string{
+	"green":   "1;92",
+	"red":     "1;91",
+	"cyan":    "1;96",
+	"yellow":  "1;93",
+	"blue":    "1;94",
+	"magenta": "1;95",
+}
+
+type line struct {
+	text  string
+	style string
+}
+
+// pane is a scrolling text area which keeps last lines
+type pane struct {
+	title string
+	lines []line
+}
+
+func (p *pane) add(style string, text string) {
+	for _, s := range strings.Split(strings.TrimRight(text, "\n"), "\n") {
+		p.lines = append(p.lines, line{text: s, style: style})
+	}
+	if len(p.lines) > maxPaneLines {
+		p.lines = p.lines[len(p.lines)-maxPaneLines:]
+	}
+}
+
+// tail wraps lines to width and returns last height of them
+func (p *pane) tail(width int, height int) []line {
+	res := make([]line, 0)
+	for i := len(p.lines) - 1; i >= 0 && len(res) < height; i-- {
+		wrapped := wrap(p.lines[i].text, width)
+		for j := len(wrapped) - 1; j >= 0 && len(res) < height; j-- {
+			res = append(res, line{text: wrapped[j], style: p.lines[i].style})
+		}
+	}
+	for i, j := 0, len(res)-1; i < j; i, j = i+1, j-1 {
+		res[i], res[j] = res[j], res[i]
+	}
+	return res
+}
+
+func wrap(text string, width int) []string {
+	runes := []rune(text)
+	if width <= 0 {
+		return nil
+	}
+	if len(runes) == 0 {
+		return []string{""}
+	}
+	res := make([]string, 0)
+	for len(runes) > width {
+		res = append(res, string(runes[:width]))
+		runes = runes[width:]
+	}
+	return append(res, string(runes))
+}
+
+// fit cuts or pads text to exactly width characters
+func fit(text string, width int) string {
+	runes := []rune(text)
+	if len(runes) > width {
+		return string(runes[:width])
+	}
+	return text + strings.Repeat(" ", width-len(runes))
+}
+
+// screen draws whole frame at once, so nothing interleaves with the input line
+type screen struct {
+	out *bufio.Writer
+}
+
+func createScreen(w io.Writer) *screen {
+	return &screen{out: bufio.NewWriter(w)}
+}
+
+// view is everything shown in a frame
+type view struct {
+	header string
+	chat   *pane
+	log    *pane
+	seats  []line
+	hint   string
+	prompt string
+	input  []rune
+	cursor int
+}
+
+func (s *screen) enter() {
+	s.out.WriteString("\x1b[?1049h\x1b[2J")
+	s.out.Flush()
+}
+
+func (s *screen) leave() {
+	s.out.WriteString("\x1b[0m\x1b[?25h\x1b[?1049l")
+	s.out.Flush()
+}
+
+func (s *screen) cell(row int, col int, style string, text string) {
+	fmt.Fprintf(s.out, "\x1b[%v;%vH", row, col)
+	if style != styleNone {
+		fmt.Fprintf(s.out, "\x1b[%vm%v\x1b[0m", style, text)
+	} else {
+		s.out.WriteString(text)
+	}
+}
+
+func (s *screen) draw(width int, height int, v *view) {
+	if width < 20 || height < 8 {
+		return
+	}
+	s.out.WriteString("\x1b[?25l")
+
+	seatsW := width / 3
+	if seatsW > 36 {
+		seatsW = 36
+	}
+	leftW := width - seatsW - 1
+	// header, two pane titles, hint and input take a row each
+	body := height - 5
+	chatH := body / 2
+	logH := body - chatH
+
+	s.cell(1, 1, styleHeader, fit(" "+v.header, width))
+
+	row := 2
+	s.cell(row, 1, styleBold, fit(v.chat.title, leftW))
+	row++
+	s.column(row, 1, leftW, chatH, v.chat.tail(leftW, chatH))
+	row += chatH
+	s.cell(row, 1, styleBold, fit(v.log.title, leftW))
+	row++
+	s.column(row, 1, leftW, logH, v.log.tail(leftW, logH))
+
+	for r := 2; r < height-1; r++ {
+		s.cell(r, leftW+1, styleDim, "│")
+	}
+	s.column(2, leftW+2, seatsW, height-3, v.seats)
+
+	s.cell(height-1, 1, styleDim, fit(v.hint, width))
+
+	// input is scrolled so that cursor is always visible
+	prompt := []rune(v.prompt)
+	inputW := width - len(prompt) - 1
+	start := 0
+	if v.cursor > inputW {
+		start = v.cursor - inputW
+	}
+	end := start + inputW
+	if end > len(v.input) {
+		end = len(v.input)
+	}
+	s.cell(height, 1, styleBold, v.prompt)
+	s.cell(height, len(prompt)+1, styleNone, fit(string(v.input[start:end]), inputW))
+	fmt.Fprintf(s.out, "\x1b[%v;%vH\x1b[?25h", height, len(prompt)+1+v.cursor-start)
+	s.out.Flush()
+}
+
+// column draws lines top to bottom and clears the rest of the area
+func (s *screen) column(row int, col int, width int, height int, lines []line) {
+	for i := 0; i < height; i++ {
+		if i < len(lines) {
+			s.cell(row+i, col, lines[i].style, fit(lines[i].text, width))
+		} else {
+			s.cell(row+i, col, styleNone, strings.Repeat(" ", width))
+		}
+	}
+}
@@ -0,0 +1,64 @@
+package gateway
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/GandarfHSE/go-mafia/internal/proto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+)
+
+type Options struct {
+	// Lobby server address
+	Addr  string
+	Creds credentials.TransportCredentials
+	// How many undelivered messages are kept per web client
+	QueueSize int
+	// REST sessions without requests and event stream are closed after it, 0 disables expiration
+	SessionTTL time.Duration
+}
+
+// Gateway lets players without gRPC clients play, every connected web client gets its own Session
+type Gateway struct {
+	opts  Options
+	conn  *grpc.ClientConn
+	lobby proto.LobbyClient
+	api   *restAPI
+	stop  context.CancelFunc
+}
+
+func CreateGateway(opts Options) (*Gateway, error) {
+	conn, err := grpc.Dial(opts.Addr, grpc.WithTransportCredentials(opts.Creds))
+	if err != nil {
+		return nil, err
+	}
+	ctx, stop := context.WithCancel(context.Background())
+	g := &Gateway{
+		opts:  opts,
+		conn:  conn,
+		lobby: proto.NewLobbyClient(conn),
+		stop:  stop,
+	}
+	g.api = g.createRestAPI()
+	if opts.SessionTTL > 0 {
+		go g.api.expire(ctx, opts.SessionTTL)
+	}
+	return g, nil
+}
+
+func (g *Gateway) Handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.Handle("/", staticHandler())
+	mux.HandleFunc("/i18n", handleCatalog)
+	mux.Handle("/ws", g.websocketHandler())
+	mux.Handle("/api/", g.api)
+	return mux
+}
+
+func (g *Gateway) Close() {
+	g.stop()
+	g.api.closeAll()
+	g.conn.Close()
+}
@@ -0,0 +1,424 @@
+package gateway
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/GandarfHSE/go-mafia/internal/proto"
+	"github.com/GandarfHSE/go-mafia/internal/utils/logger"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	pb "google.golang.org/protobuf/proto"
+)
+
+const (
+	keepAliveInterval time.Duration = 15 * time.Second
+)
+
+var (
+	errNoToken       = errors.New("missing or unknown token")
+	errUnknownGame   = errors.New("unknown game")
+	errNotFound      = errors.New("not found")
+	errBadMethod     = errors.New("method not allowed")
+	errStreamOpened  = errors.New("event stream is already opened")
+	errNoFlushWriter = errors.New("streaming is not supported")
+)
+
+// restSession is a session of REST client, it is found by bearer token
+type restSession struct {
+	*Session
+	token string
+
+	mu        sync.Mutex
+	lastSeen  time.Time
+	streaming bool
+}
+
+func (rs *restSession) touch() {
+	rs.mu.Lock()
+	rs.lastSeen = time.Now()
+	rs.mu.Unlock()
+}
+
+func (rs *restSession) idle(ttl time.Duration) bool {
+	rs.mu.Lock()
+	defer rs.mu.Unlock()
+	return !rs.streaming && time.Since(rs.lastSeen) > ttl
+}
+
+type restHandler func(w http.ResponseWriter, r *http.Request, rs *restSession) error
+
+// restAPI maps Lobby and Game RPCs to JSON endpoints:
+//
+//	POST /api/lobby/join                 {"name"} -> {"token","name"}
+//	GET  /api/lobby/members
+//	POST /api/lobby/chat                 {"text"}
+//	POST /api/lobby/exit
+//	GET  /api/session                    -> {"name","gameId"}
+//	GET  /api/events                     server-sent events, same messages as websocket
+//	GET  /api/games/{id}/state|actions|role|roles|members|alive
+//	POST /api/games/{id}/chat            {"text"}
+//	POST /api/games/{id}/exit
+//	POST /api/games/{id}/{action}        {"targets":[pid]}, e.g. vote, kill, check
+//
+// All requests except join and lobby members need "Authorization: Bearer <token>".
+type restAPI struct {
+	gw *Gateway
+
+	mu       sync.Mutex
+	sessions map[string]*restSession
+}
+
+func (g *Gateway) createRestAPI() *restAPI {
+	return &restAPI{
+		gw:       g,
+		sessions: make(map[string]*restSession),
+	}
+}
+
+func (api *restAPI) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	path := strings.Trim(strings.TrimPrefix(r.URL.Path, "/api"), "/")
+	parts := strings.Split(path, "/")
+
+	var err error
+	switch {
+	case path == "lobby/join":
+		err = api.join(w, r)
+	case path == "lobby/members":
+		err = api.lobbyMembers(w, r)
+	case parts[0] == "games" && len(parts) == 3:
+		err = api.withSession(w, r, func(w http.ResponseWriter, r *http.Request, rs *restSession) error {
+			return api.game(w, r, rs, parts[1], parts[2])
+		})
+	default:
+		h, ok := map[string]restHandler{
+			"lobby/chat": api.chat,
+			"lobby/exit": api.exit,
+			"session":    api.session,
+			"events":     api.events,
+		}[path]
+		if !ok {
+			err = errNotFound
+			break
+		}
+		err = api.withSession(w, r, h)
+	}
+	if err != nil {
+		writeError(w, err)
+	}
+}
+
+func (api *restAPI) withSession(w http.ResponseWriter, r *http.Request, h restHandler) error {
+	token, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+	if !ok {
+		return errNoToken
+	}
+	api.mu.Lock()
+	rs, ok := api.sessions[token]
+	api.mu.Unlock()
+	if !ok {
+		return errNoToken
+	}
+	rs.touch()
+	return h(w, r, rs)
+}
+
+func (api *restAPI) join(w http.ResponseWriter, r *http.Request) error {
+	req := struct {
+		Name string `json:"name"`
+	}{}
+	if err := readJSON(r, &req); err != nil {
+		return err
+	}
+
+	token, err := newToken()
+	if err != nil {
+		return err
+	}
+	s := api.gw.createSession()
+	if err := s.Join(req.Name); err != nil {
+		s.Close()
+		return err
+	}
+	rs := &restSession{Session: s, token: token, lastSeen: time.Now()}
+	api.mu.Lock()
+	api.sessions[token] = rs
+	api.mu.Unlock()
+	slog.Info("REST client joined", logger.Player, req.Name)
+
+	return writeJSON(w, http.StatusCreated, map[string]string{"token": token, "name": req.Name})
+}
+
+func (api *restAPI) lobbyMembers(w http.ResponseWriter, r *http.Request) error {
+	if r.Method != http.MethodGet {
+		return errBadMethod
+	}
+	resp, err := api.gw.lobby.MemberList(r.Context(), &proto.Empty{})
+	if err != nil {
+		return err
+	}
+	return writeProto(w, resp)
+}
+
+func (api *restAPI) chat(w http.ResponseWriter, r *http.Request, rs *restSession) error {
+	req := struct {
+		Text string `json:"text"`
+	}{}
+	if err := readJSON(r, &req); err != nil {
+		return err
+	}
+	if err := rs.Say(req.Text); err != nil {
+		return err
+	}
+	w.WriteHeader(http.StatusNoContent)
+	return nil
+}
+
+// exit leaves lobby or game, the token is not valid after it
+func (api *restAPI) exit(w http.ResponseWriter, r *http.Request, rs *restSession) error {
+	if r.Method != http.MethodPost {
+		return errBadMethod
+	}
+	api.remove(rs)
+	w.WriteHeader(http.StatusNoContent)
+	return nil
+}
+
+func (api *restAPI) session(w http.ResponseWriter, r *http.Request, rs *restSession) error {
+	if r.Method != http.MethodGet {
+		return errBadMethod
+	}
+	return writeJSON(w, http.StatusOK, map[string]string{"name": rs.Name(), "gameId": rs.GameID()})
+}
+
+// events streams session messages, only one stream per session is allowed
+// because every message is delivered once
+func (api *restAPI) events(w http.ResponseWriter, r *http.Request, rs *restSession) error {
+	if r.Method != http.MethodGet {
+		return errBadMethod
+	}
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		return errNoFlushWriter
+	}
+	rs.mu.Lock()
+	if rs.streaming {
+		rs.mu.Unlock()
+		return errStreamOpened
+	}
+	rs.streaming = true
+	rs.mu.Unlock()
+	defer func() {
+		rs.mu.Lock()
+		rs.streaming = false
+		rs.lastSeen = time.Now()
+		rs.mu.Unlock()
+	}()
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.WriteHeader(http.StatusOK)
+	flusher.Flush()
+
+	for {
+		ctx, cancel := context.WithTimeout(r.Context(), keepAliveInterval)
+		msg, err := rs.Next(ctx)
+		cancel()
+		if errors.Is(err, context.DeadlineExceeded) && r.Context().Err() == nil {
+			// comment lines keep proxies from closing idle stream
+			fmt.Fprint(w, ": ping\n\n")
+			flusher.Flush()
+			continue
+		}
+		if err != nil {
+			return nil
+		}
+		data := msg.Data
+		if data == nil {
+			data = json.RawMessage("null")
+		}
+		fmt.Fprintf(w, "event: %v\ndata: %s\n\n", msg.Type, data)
+		flusher.Flush()
+	}
+}
+
+func (api *restAPI) game(w http.ResponseWriter, r *http.Request, rs *restSession, id string, op string) error {
+	game, pl, err := rs.Game()
+	if err != nil {
+		return err
+	}
+	if id != rs.GameID() {
+		return errUnknownGame
+	}
+
+	if r.Method == http.MethodGet {
+		var resp pb.Message
+		switch op {
+		case "state":
+			resp, err = game.GetState(r.Context(), &proto.StateRequest{Player: pl})
+		case "actions":
+			resp, err = game.AvailableActions(r.Context(), &proto.StateRequest{Player: pl})
+		case "role":
+			resp, err = game.Role(r.Context(), &proto.RoleRequest{Player: pl})
+		case "roles":
+			resp, err = game.RoleList(r.Context(), &proto.Empty{})
+		case "members":
+			resp, err = game.MemberList(r.Context(), &proto.Empty{})
+		case "alive":
+			resp, err = game.AliveList(r.Context(), &proto.Empty{})
+		default:
+			return errNotFound
+		}
+		if err != nil {
+			return err
+		}
+		return writeProto(w, resp)
+	}
+
+	if r.Method != http.MethodPost {
+		return errBadMethod
+	}
+	switch op {
+	case "chat":
+		return api.chat(w, r, rs)
+	case "exit":
+		return api.exit(w, r, rs)
+	}
+	req := struct {
+		Targets []int32 `json:"targets"`
+	}{}
+	if err := readJSON(r, &req); err != nil {
+		return err
+	}
+	resp, err := rs.Perform(op, req.Targets)
+	if err != nil {
+		return err
+	}
+	return writeProto(w, resp)
+}
+
+func (api *restAPI) remove(rs *restSession) {
+	api.mu.Lock()
+	delete(api.sessions, rs.token)
+	api.mu.Unlock()
+	rs.Close()
+}
+
+// expire closes sessions of clients which neither stream events nor make requests
+func (api *restAPI) expire(ctx context.Context, ttl time.Duration) {
+	ticker := time.NewTicker(ttl / 2)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		api.mu.Lock()
+		idle := make([]*restSession, 0)
+		for _, rs := range api.sessions {
+			if rs.idle(ttl) {
+				idle = append(idle, rs)
+			}
+		}
+		api.mu.Unlock()
+		for _, rs := range idle {
+			slog.Info("REST session expired")
+			api.remove(rs)
+		}
+	}
+}
+
+func (api *restAPI) closeAll() {
+	api.mu.Lock()
+	sessions := api.sessions
+	api.sessions = make(map[string]*restSession)
+	api.mu.Unlock()
+	for _, rs := range sessions {
+		rs.Close()
+	}
+}
+
+func newToken() (string, error) {
+	buf := make([]byte, 16)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(buf), nil
+}
+
+func readJSON(r *http.Request, v any) error {
+	if r.Method != http.MethodPost {
+		return errBadMethod
+	}
+	if err := json.NewDecoder(r.Body).Decode(v); err != nil {
+		return status.Errorf(codes.InvalidArgument, "bad json: %v", err)
+	}
+	return nil
+}
+
+func writeJSON(w http.ResponseWriter, code int, v any) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	return json.NewEncoder(w).Encode(v)
+}
+
+func writeProto(w http.ResponseWriter, m pb.Message) error {
+	data, err := marshaler.Marshal(m)
+	if err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	_, err = w.Write(data)
+	return err
+}
+
+// writeError answers with the same reason and message as websocket error messages
+func writeError(w http.ResponseWriter, err error) {
+	writeJSON(w, httpStatus(err), errorMessage(err))
+}
+
+func httpStatus(err error) int {
+	switch err {
+	case errNoToken:
+		return http.StatusUnauthorized
+	case errUnknownGame, errNotFound:
+		return http.StatusNotFound
+	case errBadMethod:
+		return http.StatusMethodNotAllowed
+	case errNotInGame, errJoined, errStreamOpened:
+		return http.StatusConflict
+	case errNoFlushWriter:
+		return http.StatusInternalServerError
+	}
+
+	switch status.Code(err) {
+	case codes.InvalidArgument:
+		return http.StatusBadRequest
+	case codes.NotFound:
+		return http.StatusNotFound
+	case codes.AlreadyExists, codes.FailedPrecondition:
+		return http.StatusConflict
+	case codes.PermissionDenied:
+		return http.StatusForbidden
+	case codes.Unauthenticated:
+		return http.StatusUnauthorized
+	case codes.ResourceExhausted:
+		return http.StatusTooManyRequests
+	case codes.Unavailable:
+		return http.StatusServiceUnavailable
+	case codes.DeadlineExceeded:
+		return http.StatusGatewayTimeout
+	}
+	return http.StatusInternalServerError
+}
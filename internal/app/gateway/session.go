@@ -0,0 +1,396 @@
+package gateway
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/GandarfHSE/go-mafia/internal/app/client"
+	"github.com/GandarfHSE/go-mafia/internal/proto"
+	"github.com/GandarfHSE/go-mafia/internal/utils/fanout"
+	"github.com/GandarfHSE/go-mafia/internal/utils/grpcutil"
+	"github.com/GandarfHSE/go-mafia/internal/utils/logger"
+	"github.com/GandarfHSE/go-mafia/internal/utils/player"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	pb "google.golang.org/protobuf/proto"
+)
+
+// exitTimeout limits Exit sent on close, session context is cancelled by then
+const exitTimeout time.Duration = time.Second
+
+// Types of messages sent to web clients
+const (
+	MsgJoined    string = "joined"
+	MsgMembers   string = "members"
+	MsgChat      string = "chat"
+	MsgGame      string = "game"
+	MsgRoles     string = "roles"
+	MsgEvent     string = "event"
+	MsgState     string = "state"
+	MsgPerformed string = "performed"
+	MsgError     string = "error"
+)
+
+// Web client relies on zero values being present, e.g. pid 0 or alive false
+var marshaler = protojson.MarshalOptions{EmitUnpopulated: true}
+
+var (
+	errNotInGame = errors.New("not in game")
+	errJoined    = errors.New("already joined")
+)
+
+// Message is sent to web client, data depends on type
+type Message struct {
+	Type string          `json:"type"`
+	Data json.RawMessage `json:"data,omitempty"`
+}
+
+// ChatMessage is chat line with message key, clients render it themselves
+type ChatMessage struct {
+	Server bool     `json:"server"`
+	Key    string   `json:"key"`
+	Args   []string `json:"args,omitempty"`
+}
+
+// ErrorMessage carries reason of gRPC error with its arguments, so client can render it in its language
+type ErrorMessage struct {
+	Reason  string   `json:"reason,omitempty"`
+	Args    []string `json:"args,omitempty"`
+	Message string   `json:"message"`
+}
+
+func errorMessage(err error) ErrorMessage {
+	msg := ErrorMessage{Message: err.Error()}
+	if reason := grpcutil.Reason(err); reason != proto.ErrorReason_ERROR_REASON_UNSPECIFIED {
+		msg.Reason = reason.String()
+		msg.Args = grpcutil.Args(err)
+	}
+	if st, ok := status.FromError(err); ok {
+		msg.Message = st.Message()
+	}
+	return msg
+}
+
+// StateMessage is game state together with actions available in it
+type StateMessage struct {
+	State   json.RawMessage   `json:"state"`
+	Actions []json.RawMessage `json:"actions"`
+}
+
+// Session is a player connected through the gateway. It talks to lobby and game
+// like any other client and queues everything for the web client.
+type Session struct {
+	gw  *Gateway
+	out *fanout.Queue[Message]
+
+	mu     sync.Mutex
+	player *proto.Player
+	chat   *net.UDPConn
+	conn   *grpc.ClientConn
+	game   proto.GameClient
+	gameID string
+	closed bool
+	ctx    context.Context
+	cancel context.CancelFunc
+}
+
+func (g *Gateway) createSession() *Session {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &Session{
+		gw:     g,
+		out:    fanout.CreateQueue[Message](fanout.Options{Size: g.opts.QueueSize, Policy: fanout.DropOldest}, nil),
+		ctx:    ctx,
+		cancel: cancel,
+	}
+}
+
+// Next waits for the next message to web client, io.EOF means session is closed
+func (s *Session) Next(ctx context.Context) (Message, error) {
+	return s.out.Pop(ctx)
+}
+
+func (s *Session) send(typ string, data any) {
+	raw, err := json.Marshal(data)
+	if err != nil {
+		slog.Error("Can't marshal gateway message", "type", typ, logger.Error, err)
+		return
+	}
+	s.out.Push(Message{Type: typ, Data: raw})
+}
+
+func (s *Session) sendProto(typ string, m pb.Message) {
+	raw, err := marshaler.Marshal(m)
+	if err != nil {
+		slog.Error("Can't marshal gateway message", "type", typ, logger.Error, err)
+		return
+	}
+	s.out.Push(Message{Type: typ, Data: raw})
+}
+
+func (s *Session) sendError(err error) {
+	s.send(MsgError, errorMessage(err))
+}
+
+// Join enters the lobby and starts waiting for the game
+func (s *Session) Join(name string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.player != nil {
+		return errJoined
+	}
+
+	if s.chat == nil {
+		chat, err := net.ListenUDP("udp", &net.UDPAddr{})
+		if err != nil {
+			return err
+		}
+		s.chat = chat
+		go s.readChat(chat)
+	}
+	pl := &proto.Player{Name: name, Addr: fmt.Sprintf(":%v", s.chat.LocalAddr().(*net.UDPAddr).Port)}
+
+	if _, err := s.gw.lobby.Join(s.ctx, &proto.JoinRequest{Player: pl}); err != nil {
+		return err
+	}
+	s.player = pl
+	s.send(MsgJoined, pl.Name)
+	go s.refreshMembers()
+	go s.waitForGame(pl)
+	return nil
+}
+
+func (s *Session) waitForGame(pl *proto.Player) {
+	resp, err := s.gw.lobby.SubscribeToGame(s.ctx, &proto.SubscribeToGameRequest{Player: pl})
+	if err != nil {
+		if s.ctx.Err() == nil {
+			s.sendError(err)
+		}
+		return
+	}
+	s.startGame(resp.GameAddr, resp.GameId)
+}
+
+func (s *Session) readChat(conn *net.UDPConn) {
+	buf := make([]byte, 8192)
+	for {
+		n, _, err := conn.ReadFrom(buf)
+		if err != nil {
+			return
+		}
+		from, msg, err := player.ParseChat(string(buf[:n]))
+		if err != nil {
+			continue
+		}
+		s.send(MsgChat, ChatMessage{Server: from == player.ServerSender, Key: msg.Key, Args: msg.Args})
+
+		// joins and leaves are announced in chat
+		if msg.Key != "chat.message" {
+			s.mu.Lock()
+			inLobby := s.game == nil
+			s.mu.Unlock()
+			if inLobby {
+				s.refreshMembers()
+			}
+		}
+	}
+}
+
+func (s *Session) refreshMembers() {
+	resp, err := s.gw.lobby.MemberList(s.ctx, &proto.Empty{})
+	if err != nil {
+		return
+	}
+	names := resp.PlayerNames
+	if names == nil {
+		names = []string{}
+	}
+	s.send(MsgMembers, names)
+}
+
+// Name is empty until player joins
+func (s *Session) Name() string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.player == nil {
+		return ""
+	}
+	return s.player.Name
+}
+
+// GameID is empty until the game starts
+func (s *Session) GameID() string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.gameID
+}
+
+// Game returns client of the player's game and the player as game knows it
+func (s *Session) Game() (proto.GameClient, *proto.Player, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.game == nil {
+		return nil, nil, errNotInGame
+	}
+	return s.game, s.player, nil
+}
+
+// Say sends chat message to lobby or game, whichever player is in
+func (s *Session) Say(text string) error {
+	s.mu.Lock()
+	pl, game := s.player, s.game
+	s.mu.Unlock()
+	if pl == nil {
+		return errNotInGame
+	}
+
+	req := &proto.SendMessageRequest{Player: pl, Msg: text}
+	var err error
+	if game != nil {
+		_, err = game.SendMessage(s.ctx, req)
+	} else {
+		_, err = s.gw.lobby.SendMessage(s.ctx, req)
+	}
+	return err
+}
+
+func (s *Session) startGame(gameAddr string, gameID string) {
+	conn, err := grpc.Dial(client.ResolveGameAddr(s.gw.opts.Addr, gameAddr), grpc.WithTransportCredentials(s.gw.opts.Creds))
+	if err != nil {
+		s.sendError(err)
+		return
+	}
+
+	s.mu.Lock()
+	if s.player == nil {
+		s.mu.Unlock()
+		conn.Close()
+		return
+	}
+	s.conn = conn
+	s.game = proto.NewGameClient(conn)
+	s.gameID = gameID
+	game, pl := s.game, s.player
+	s.mu.Unlock()
+	s.send(MsgGame, map[string]string{"id": gameID})
+
+	roles, err := game.RoleList(s.ctx, &proto.Empty{})
+	if err != nil {
+		s.sendError(err)
+		return
+	}
+	role, err := game.Role(s.ctx, &proto.RoleRequest{Player: pl})
+	if err != nil {
+		s.sendError(err)
+		return
+	}
+	roles.Roles = append(roles.Roles, role.Info)
+	raw, _ := marshaler.Marshal(roles)
+	s.send(MsgRoles, map[string]any{"role": role.Role, "roles": json.RawMessage(raw)})
+
+	s.events(game, pl)
+}
+
+// events forwards game events until the game ends, resubscribing after server restarts
+func (s *Session) events(game proto.GameClient, pl *proto.Player) {
+	s.refreshState()
+	err := client.FollowEvents(s.ctx, game, pl, client.Hooks{
+		Event: func(e *proto.GameEvent) {
+			s.sendProto(MsgEvent, e)
+			if e.Type != proto.EventType_EVENT_TYPE_GAME_END {
+				s.refreshState()
+			}
+		},
+		Reconnected: func(*proto.GameStateResponse) {
+			s.refreshState()
+		},
+	})
+	if err != nil {
+		s.sendError(err)
+	}
+}
+
+// refreshState sends game state and available actions, web client redraws from them
+func (s *Session) refreshState() {
+	s.mu.Lock()
+	game, pl := s.game, s.player
+	s.mu.Unlock()
+	if game == nil {
+		return
+	}
+
+	st, err := game.GetState(s.ctx, &proto.StateRequest{Player: pl})
+	if err != nil {
+		return
+	}
+	actions, err := game.AvailableActions(s.ctx, &proto.StateRequest{Player: pl})
+	if err != nil {
+		return
+	}
+	s.sendState(st, actions.Actions)
+}
+
+func (s *Session) sendState(st *proto.GameStateResponse, actions []*proto.ActionInfo) {
+	msg := StateMessage{Actions: make([]json.RawMessage, 0)}
+	msg.State, _ = marshaler.Marshal(st)
+	for _, a := range actions {
+		raw, _ := marshaler.Marshal(a)
+		msg.Actions = append(msg.Actions, raw)
+	}
+	s.send(MsgState, msg)
+}
+
+// Perform makes game action, targets are pids
+func (s *Session) Perform(action string, targets []int32) (*proto.PerformActionResponse, error) {
+	s.mu.Lock()
+	game, pl := s.game, s.player
+	s.mu.Unlock()
+	if game == nil {
+		return nil, errNotInGame
+	}
+
+	resp, err := game.PerformAction(s.ctx, &proto.PerformActionRequest{Player: pl, ActionId: action, Targets: targets})
+	if err != nil {
+		return nil, err
+	}
+	s.send(MsgPerformed, map[string]string{"action": resp.ActionId, "confirmation": resp.Confirmation})
+	go s.refreshState()
+	return resp, nil
+}
+
+// Close leaves lobby or game and stops the session
+func (s *Session) Close() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.closed {
+		return
+	}
+	s.closed = true
+
+	// exit must reach the server even though session context is cancelled
+	ctx, cancel := context.WithTimeout(context.Background(), exitTimeout)
+	defer cancel()
+	if s.player != nil {
+		req := &proto.ExitRequest{Player: s.player}
+		if s.game != nil {
+			s.game.Exit(ctx, req)
+		} else {
+			s.gw.lobby.Exit(ctx, req)
+		}
+	}
+
+	s.cancel()
+	if s.conn != nil {
+		s.conn.Close()
+	}
+	if s.chat != nil {
+		s.chat.Close()
+	}
+	s.out.Close()
+}
@@ -0,0 +1,108 @@
+package gateway
+
+import (
+	"context"
+	"embed"
+	"encoding/json"
+	"errors"
+	"io"
+	"io/fs"
+	"log/slog"
+	"net/http"
+
+	"github.com/GandarfHSE/go-mafia/internal/utils/i18n"
+	"github.com/GandarfHSE/go-mafia/internal/utils/logger"
+	"golang.org/x/net/websocket"
+)
+
+//go:embed web
+var webFiles embed.FS
+
+// Request types of web clients
+const (
+	ReqJoin   string = "join"
+	ReqChat   string = "chat"
+	ReqAction string = "action"
+	ReqExit   string = "exit"
+)
+
+// Request is sent by web client over websocket
+type Request struct {
+	Type    string  `json:"type"`
+	Name    string  `json:"name,omitempty"`
+	Text    string  `json:"text,omitempty"`
+	Action  string  `json:"action,omitempty"`
+	Targets []int32 `json:"targets,omitempty"`
+}
+
+var errUnknownRequest = errors.New("unknown request")
+
+func staticHandler() http.Handler {
+	root, _ := fs.Sub(webFiles, "web")
+	return http.FileServer(http.FS(root))
+}
+
+// handleCatalog serves messages, so web client renders server message keys itself
+func handleCatalog(w http.ResponseWriter, r *http.Request) {
+	lang := r.URL.Query().Get("lang")
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]any{
+		"lang":     i18n.CreatePrinter(lang).Lang(),
+		"messages": i18n.Catalog(lang),
+	})
+}
+
+// websocketHandler bridges websocket to a new session: requests come in, session messages go out
+func (g *Gateway) websocketHandler() http.Handler {
+	return websocket.Handler(func(ws *websocket.Conn) {
+		s := g.createSession()
+		defer s.Close()
+		slog.Info("Web client connected", "addr", ws.Request().RemoteAddr)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		go func() {
+			defer ws.Close()
+			for {
+				msg, err := s.Next(ctx)
+				if err != nil {
+					return
+				}
+				if err := websocket.JSON.Send(ws, msg); err != nil {
+					return
+				}
+			}
+		}()
+
+		for {
+			req := Request{}
+			err := websocket.JSON.Receive(ws, &req)
+			if err == io.EOF {
+				break
+			}
+			if err != nil {
+				slog.Info("Web client disconnected", logger.Error, err)
+				break
+			}
+			if req.Type == ReqExit {
+				break
+			}
+			if err := s.handle(req); err != nil {
+				s.sendError(err)
+			}
+		}
+	})
+}
+
+func (s *Session) handle(req Request) error {
+	switch req.Type {
+	case ReqJoin:
+		return s.Join(req.Name)
+	case ReqChat:
+		return s.Say(req.Text)
+	case ReqAction:
+		_, err := s.Perform(req.Action, req.Targets)
+		return err
+	}
+	return errUnknownRequest
+}
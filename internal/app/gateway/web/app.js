@@ -0,0 +1,417 @@
+"use strict";
+
+// Server sends message keys, they are rendered with the catalog from /i18n
+let catalog = {};
+let ws = null;
+let roles = {};
+let myRole = "";
+let state = null;
+let actions = [];
+let ended = false;
+// Pids chosen for actions taking several targets, keyed by action id
+let picked = {};
+
+const $ = (id) => document.getElementById(id);
+
+function t(key, ...args) {
+  let format = catalog[key];
+  if (format === undefined) {
+    return key;
+  }
+  return format.replace(/%v/g, () => (args.length ? args.shift() : ""));
+}
+
+function el(tag, text, cls) {
+  const e = document.createElement(tag);
+  if (text !== undefined) {
+    e.textContent = text;
+  }
+  if (cls) {
+    e.className = cls;
+  }
+  return e;
+}
+
+function append(feed, text, cls) {
+  const box = $(feed);
+  box.appendChild(el("div", text, cls));
+  box.scrollTop = box.scrollHeight;
+}
+
+function showError(text) {
+  $("error").textContent = text;
+  setTimeout(() => {
+    if ($("error").textContent === text) {
+      $("error").textContent = "";
+    }
+  }, 5000);
+}
+
+async function loadCatalog(lang) {
+  const resp = await fetch("i18n?lang=" + encodeURIComponent(lang));
+  const data = await resp.json();
+  catalog = data.messages;
+  document.documentElement.lang = data.lang;
+  $("lang").value = data.lang;
+  for (const e of document.querySelectorAll("[data-key]")) {
+    e.textContent = t(e.dataset.key);
+  }
+  render();
+}
+
+function roleTitle(role) {
+  return roles[role] ? t(roles[role].title) : role;
+}
+
+function roleColor(role) {
+  return roles[role] ? roles[role].color : "";
+}
+
+function teamTitle(team) {
+  for (const info of Object.values(roles)) {
+    if (info.team === team) {
+      return t(info.teamTitle);
+    }
+  }
+  return "";
+}
+
+function send(req) {
+  if (ws && ws.readyState === WebSocket.OPEN) {
+    ws.send(JSON.stringify(req));
+  }
+}
+
+function connect(name) {
+  ws = new WebSocket((location.protocol === "https:" ? "wss://" : "ws://") + location.host + location.pathname.replace(/[^/]*$/, "") + "ws");
+  ws.onopen = () => send({ type: "join", name: name });
+  ws.onmessage = (e) => handle(JSON.parse(e.data));
+  ws.onclose = () => {
+    if (!ended) {
+      showError(t("web.closed"));
+    }
+  };
+}
+
+function handle(msg) {
+  const data = msg.data;
+  switch (msg.type) {
+    case "joined":
+      $("login").hidden = true;
+      $("main").hidden = false;
+      append("chat", t("lobby.hello", data), "bold");
+      break;
+    case "members":
+      // late lobby updates may arrive after the game has started
+      if (state) {
+        break;
+      }
+      $("seats-title").textContent = t("web.members");
+      $("seats").replaceChildren(...data.map((name) => el("li", name)));
+      $("status").textContent = t("lobby.players_count", data.length) + " " + t("web.waiting");
+      break;
+    case "chat":
+      append("chat", t(data.key, ...(data.args || [])), data.server ? "server" : "");
+      break;
+    case "game":
+      $("log").hidden = false;
+      append("log", t("game.start"), "bold");
+      break;
+    case "roles":
+      roles = {};
+      for (const info of data.roles.roles) {
+        roles[info.id] = info;
+      }
+      myRole = data.role;
+      append("log", t("game.your_role") + roleTitle(myRole), roleColor(myRole));
+      break;
+    case "event":
+      handleEvent(data);
+      break;
+    case "state":
+      state = data.state;
+      actions = data.actions;
+      render();
+      break;
+    case "performed":
+      picked = {};
+      if (data.confirmation) {
+        append("log", t(data.confirmation), "dim");
+      }
+      break;
+    case "error":
+      showError(errorText(data));
+      break;
+  }
+}
+
+function errorText(err) {
+  if (err.reason) {
+    const key = "error." + err.reason.replace("ERROR_REASON_", "").toLowerCase();
+    if (catalog[key] !== undefined) {
+      return t(key, ...(err.args || []));
+    }
+  }
+  return err.message;
+}
+
+function handleEvent(e) {
+  switch (e.type) {
+    case "EVENT_TYPE_PHASE_CHANGED": {
+      const ev = e.phaseChanged;
+      if (ev.phase === "PHASE_NIGHT") {
+        append("log", t("state.night", ev.day), "bold");
+        const info = roles[myRole];
+        if (info && info.nightHint && (!state || state.alive)) {
+          append("log", t(info.nightHint));
+        }
+      } else if (ev.phase === "PHASE_LAST_WORDS") {
+        append("log", t("state.last_words", ev.day), "bold");
+      } else if (ev.phase === "PHASE_VOTING") {
+        append("log", t("state.voting", ev.day), "bold");
+      } else if (ev.phase === "PHASE_MEETING") {
+        append("log", t("state.meeting"), "bold");
+      } else {
+        append("log", t("state.day", ev.day), "bold");
+      }
+      break;
+    }
+    case "EVENT_TYPE_PLAYER_KILLED":
+      append("log", t("game.killed", e.killed.player));
+      break;
+    case "EVENT_TYPE_PLAYER_JAILED":
+      append("log", t("game.jailed", e.jailed.player));
+      break;
+    case "EVENT_TYPE_CHECK_RESULT":
+      if (seekResult(e.checkResult)) {
+        append("log", t("game." + seekResult(e.checkResult), e.checkResult.pid + 1));
+        break;
+      }
+      append("log", t("game.check_result", e.checkResult.pid + 1) + roleTitle(e.checkResult.role), roleColor(e.checkResult.role));
+      break;
+    case "EVENT_TYPE_VOTE_TURN":
+      if (state && e.voteTurn.pid === state.pid) {
+        append("log", t("game.your_vote_turn"), "bold");
+      } else {
+        append("log", t("game.vote_turn", e.voteTurn.player));
+      }
+      break;
+    case "EVENT_TYPE_SPEECH_TURN":
+      if (state && e.speechTurn.pid === state.pid) {
+        append("log", t("game.your_speech"), "bold");
+      } else {
+        append("log", t("game.speech_turn", e.speechTurn.player));
+      }
+      break;
+    case "EVENT_TYPE_YOU_DEAD":
+      append("log", t("game.you_dead"), "red");
+      break;
+    case "EVENT_TYPE_SERVER_SHUTDOWN":
+      if (e.shutdown.drainSeconds > 0) {
+        append("log", t("game.shutdown_drain", e.shutdown.drainSeconds), "red");
+      } else {
+        append("log", t("game.shutdown"), "red");
+      }
+      break;
+    case "EVENT_TYPE_GAME_END":
+      endGame(e.end);
+      break;
+  }
+}
+
+// seekResult returns message key suffix for the result of seeking, empty for other checks
+function seekResult(check) {
+  if (check.action !== "seek") {
+    return "";
+  }
+  return check.role ? "seek_found" : "seek_missed";
+}
+
+function endGame(ev) {
+  ended = true;
+  actions = [];
+  append("log", t("game.over"), "bold");
+  const team = teamTitle(ev.won);
+  append("log", team ? t("game.winner", team) : t("game.no_winner"), "bold");
+  append("log", t("game.roles"));
+  ev.playerNames.forEach((name, i) => {
+    append("log", "#" + (i + 1) + ". " + name + " - " + roleTitle(ev.roles[i]), roleColor(ev.roles[i]));
+  });
+  // reveal all roles in the seat list
+  if (state) {
+    state.ended = true;
+    state.seats.forEach((seat, i) => (seat.role = ev.roles[i] || seat.role));
+  }
+  $("again").hidden = false;
+  render();
+}
+
+function clock(seconds) {
+  const m = Math.floor(seconds / 60);
+  const s = seconds % 60;
+  return m + ":" + (s < 10 ? "0" : "") + s;
+}
+
+function renderStatus() {
+  if (!state) {
+    return;
+  }
+  const parts = [];
+  if (state.ended) {
+    parts.push(t("tui.ended"));
+  } else {
+    if (state.phase === "PHASE_LAST_WORDS") {
+      parts.push(t("tui.last_words"));
+    } else if (state.phase === "PHASE_VOTING") {
+      parts.push(t("tui.voting"));
+    } else if (state.phase === "PHASE_MEETING") {
+      parts.push(t("tui.meeting"));
+    } else {
+      parts.push(t(state.phase === "PHASE_NIGHT" ? "tui.night" : "tui.day", state.day));
+    }
+    if (state.deadline) {
+      const left = Math.max(0, Math.round((Date.parse(state.deadline) - Date.now()) / 1000));
+      parts.push(t("tui.left", clock(left)));
+    }
+    if (state.paused) {
+      parts.push(t("tui.paused"));
+    }
+    if (!state.alive) {
+      parts.push(t("tui.dead"));
+    }
+  }
+  $("status").textContent = parts.join(" · ");
+}
+
+function render() {
+  if (!state) {
+    return;
+  }
+  renderStatus();
+  $("role").textContent = t("game.your_role") + roleTitle(myRole);
+  $("role").className = "bold " + roleColor(myRole);
+
+  $("seats-title").textContent = t("state.players");
+  const seats = state.seats.map((seat) => {
+    let text = "#" + (seat.pid + 1) + ". " + seat.name;
+    if (seat.pid === state.pid) {
+      text += t("state.you");
+    }
+    if (seat.role) {
+      text += " - " + roleTitle(seat.role);
+    }
+    if (!seat.connected && seat.alive) {
+      text += t("state.disconnected");
+    }
+    if ((state.nominees || []).includes(seat.pid)) {
+      text += t("state.nominee");
+    }
+    if (seat.pid === state.voter) {
+      text += t("state.voter");
+    }
+    if (seat.pid === state.speaker) {
+      text += t("state.speaker");
+    }
+    if (seat.fouls > 0) {
+      text += t("state.fouls", seat.fouls);
+    }
+    const vote = state.votes.length ? state.votes[seat.pid] : -2;
+    if (vote === -1) {
+      text += t("state.no_vote");
+    } else if (vote >= 0) {
+      text += t("state.vote", vote + 1);
+    }
+    if (state.votesCount.length && state.votesCount[seat.pid] > 0) {
+      text += " [" + state.votesCount[seat.pid] + "]";
+    }
+    let cls = seat.pid === state.pid ? "you " : "";
+    cls += seat.alive ? roleColor(seat.role) : "dead";
+    return el("li", text, cls);
+  });
+  $("seats").replaceChildren(...seats);
+
+  $("checks").replaceChildren(
+    ...state.checks.map((c) =>
+      seekResult(c) ? el("div", t("state." + seekResult(c), c.day, c.pid + 1)) : el("div", t("state.check", c.day, c.pid + 1) + roleTitle(c.role), roleColor(c.role))
+    )
+  );
+
+  const buttons = [];
+  for (const a of actions) {
+    buttons.push(el("div", t(a.title), "bold"));
+    if (a.arg !== "ARG_TYPE_PLAYER") {
+      buttons.push(actionButton(a.id, t(a.title), []));
+      continue;
+    }
+    if (a.maxTargets > 1) {
+      buttons.push(...pickButtons(a));
+      continue;
+    }
+    for (const pid of a.targets) {
+      const seat = state.seats[pid];
+      buttons.push(actionButton(a.id, "#" + (pid + 1) + " " + (seat ? seat.name : ""), [pid]));
+    }
+    if (a.canSkip) {
+      buttons.push(actionButton(a.id, t("web.skip"), [-1]));
+    }
+  }
+  $("actions").replaceChildren(...buttons);
+}
+
+// pickButtons toggle targets of the action, chosen ones are sent together
+function pickButtons(a) {
+  const chosen = picked[a.id] || [];
+  const buttons = a.targets.map((pid) => {
+    const seat = state.seats[pid];
+    const b = el("button", "#" + (pid + 1) + " " + (seat ? seat.name : ""), chosen.includes(pid) ? "picked" : "");
+    b.onclick = () => {
+      picked[a.id] = chosen.includes(pid) ? chosen.filter((p) => p !== pid) : [...chosen, pid].slice(0, a.maxTargets);
+      render();
+    };
+    return b;
+  });
+  const done = actionButton(a.id, t("web.send"), chosen);
+  done.disabled = chosen.length === 0;
+  buttons.push(done);
+  return buttons;
+}
+
+function actionButton(id, text, targets) {
+  const b = el("button", text);
+  b.onclick = () => send({ type: "action", action: id, targets: targets });
+  return b;
+}
+
+$("login-form").onsubmit = (e) => {
+  e.preventDefault();
+  const name = $("name").value.trim();
+  if (!name) {
+    return;
+  }
+  if (ws && ws.readyState === WebSocket.OPEN) {
+    send({ type: "join", name: name });
+  } else {
+    connect(name);
+  }
+};
+
+$("chat-form").onsubmit = (e) => {
+  e.preventDefault();
+  const text = $("message").value.trim();
+  if (text) {
+    send({ type: "chat", text: text });
+    $("message").value = "";
+  }
+};
+
+$("lang").onchange = () => {
+  localStorage.setItem("lang", $("lang").value);
+  loadCatalog($("lang").value);
+};
+
+$("again").onclick = () => location.reload();
+
+window.onbeforeunload = () => send({ type: "exit" });
+
+setInterval(renderStatus, 1000);
+loadCatalog(localStorage.getItem("lang") || navigator.language || "");
@@ -0,0 +1,211 @@
+package loadtest
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"math/rand"
+	"net"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/GandarfHSE/go-mafia/internal/app/client"
+	"github.com/GandarfHSE/go-mafia/internal/proto"
+	"github.com/GandarfHSE/go-mafia/internal/utils/player"
+	"google.golang.org/grpc"
+)
+
+// Chat messages of bots carry send time, so receivers can measure delivery latency
+const chatPrefix string = "loadtest "
+
+const moveAttempts int = 3
+
+type bot struct {
+	cfg    *Config
+	stats  *Stats
+	lobby  proto.LobbyClient
+	player *proto.Player
+	chat   *net.UDPConn
+	rnd    *rand.Rand
+
+	game  proto.GameClient
+	alive bool
+	// Moves are triggered by phase and turn events, one move at a time
+	mu sync.Mutex
+}
+
+func (b *bot) run(ctx context.Context) error {
+	var err error
+	b.chat, err = net.ListenUDP("udp", &net.UDPAddr{})
+	if err != nil {
+		return err
+	}
+	defer b.chat.Close()
+	go b.readChat()
+	b.player.Addr = fmt.Sprintf(":%v", b.chat.LocalAddr().(*net.UDPAddr).Port)
+
+	if err := b.call(ctx, "lobby.Join", func(ctx context.Context) error {
+		_, err := b.lobby.Join(ctx, &proto.JoinRequest{Player: b.player})
+		return err
+	}); err != nil {
+		return err
+	}
+	b.sendChat(ctx, "lobby.SendMessage", b.lobby.SendMessage)
+
+	// Waiting for the game depends on other players, so it isn't limited by RPC timeout
+	start := time.Now()
+	resp, err := b.lobby.SubscribeToGame(ctx, &proto.SubscribeToGameRequest{Player: b.player})
+	b.stats.Call("lobby.SubscribeToGame", start, err)
+	if err != nil {
+		return err
+	}
+
+	conn, err := grpc.Dial(client.ResolveGameAddr(b.cfg.Addr, resp.GameAddr), grpc.WithTransportCredentials(b.cfg.Creds))
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	b.game = proto.NewGameClient(conn)
+	return b.play(ctx)
+}
+
+func (b *bot) play(ctx context.Context) error {
+	stream, err := b.game.SubscribeToGameEvent(ctx, &proto.SubscribeToGameRequest{Player: b.player})
+	if err != nil {
+		return err
+	}
+	if err := b.call(ctx, "game.Role", func(ctx context.Context) error {
+		_, err := b.game.Role(ctx, &proto.RoleRequest{Player: b.player})
+		return err
+	}); err != nil {
+		return err
+	}
+	b.alive = true
+
+	for {
+		e, err := stream.Recv()
+		if err == io.EOF {
+			return errors.New("event stream is closed before game end")
+		}
+		if err != nil {
+			b.stats.Call("game.events", time.Now(), err)
+			return err
+		}
+		if e.Time != nil {
+			b.stats.Observe("game.event_delivery", time.Since(e.Time.AsTime()))
+		}
+
+		switch e.Type {
+		case proto.EventType_EVENT_TYPE_PHASE_CHANGED:
+			if b.alive {
+				// Moves are made concurrently with reading events, like real players do
+				go b.move(ctx, e.GetPhaseChanged().Phase)
+			}
+		case proto.EventType_EVENT_TYPE_VOTE_TURN:
+			if b.alive && e.GetVoteTurn().Player == b.player.Name {
+				go b.move(ctx, proto.Phase_PHASE_VOTING)
+			}
+		case proto.EventType_EVENT_TYPE_SPEECH_TURN:
+			if b.alive && e.GetSpeechTurn().Player == b.player.Name {
+				go b.move(ctx, proto.Phase_PHASE_DAY)
+			}
+		case proto.EventType_EVENT_TYPE_YOU_DEAD:
+			b.alive = false
+		case proto.EventType_EVENT_TYPE_GAME_END:
+			b.stats.GameEnded()
+			return nil
+		}
+	}
+}
+
+func (b *bot) move(ctx context.Context, phase proto.Phase) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	// Another bot may take the target first, e.g. nominate the same player, then bot chooses again
+	for i := 0; i < moveAttempts; i++ {
+		if b.act(ctx, phase == proto.Phase_PHASE_DAY && i == 0) {
+			return
+		}
+	}
+}
+
+// act makes all available actions, it reports whether all of them succeeded.
+// Bot chats only when it has actions, talking during somebody else's speech is a foul.
+func (b *bot) act(ctx context.Context, chat bool) bool {
+	var actions *proto.AvailableActionsResponse
+	if err := b.call(ctx, "game.AvailableActions", func(ctx context.Context) error {
+		var err error
+		actions, err = b.game.AvailableActions(ctx, &proto.StateRequest{Player: b.player})
+		return err
+	}); err != nil {
+		return false
+	}
+	if chat && len(actions.Actions) > 0 {
+		b.sendChat(ctx, "game.SendMessage", b.game.SendMessage)
+	}
+	ok := true
+	for _, a := range actions.Actions {
+		targets := make([]int32, 0)
+		if a.Arg == proto.ArgType_ARG_TYPE_PLAYER && len(a.Targets) > 0 {
+			targets = append(targets, a.Targets[b.rnd.Intn(len(a.Targets))])
+		} else if a.Arg == proto.ArgType_ARG_TYPE_PLAYER && a.CanSkip {
+			targets = append(targets, -1)
+		}
+		err := b.call(ctx, "game.PerformAction", func(ctx context.Context) error {
+			_, err := b.game.PerformAction(ctx, &proto.PerformActionRequest{Player: b.player, ActionId: a.Id, Targets: targets})
+			return err
+		})
+		if err != nil {
+			ok = false
+		}
+	}
+	return ok
+}
+
+type sendFunc func(ctx context.Context, req *proto.SendMessageRequest, opts ...grpc.CallOption) (*proto.Empty, error)
+
+func (b *bot) sendChat(ctx context.Context, name string, send sendFunc) {
+	for i := 0; i < b.cfg.Chat; i++ {
+		msg := fmt.Sprintf("%v%v", chatPrefix, time.Now().UnixNano())
+		b.call(ctx, name, func(ctx context.Context) error {
+			_, err := send(ctx, &proto.SendMessageRequest{Msg: msg, Player: b.player})
+			return err
+		})
+	}
+}
+
+func (b *bot) readChat() {
+	buf := make([]byte, 2048)
+	for {
+		n, _, err := b.chat.ReadFrom(buf)
+		if err != nil {
+			return
+		}
+		_, msg, err := player.ParseChat(string(buf[:n]))
+		if err != nil || msg.Key != "chat.message" || len(msg.Args) != 2 {
+			continue
+		}
+		text, found := strings.CutPrefix(msg.Args[1], chatPrefix)
+		if !found {
+			continue
+		}
+		sent, err := strconv.ParseInt(text, 10, 64)
+		if err == nil {
+			b.stats.Observe("chat_delivery", time.Since(time.Unix(0, sent)))
+		}
+	}
+}
+
+// call runs RPC with timeout and records its outcome
+func (b *bot) call(ctx context.Context, name string, rpc func(ctx context.Context) error) error {
+	ctx, cancel := context.WithTimeout(ctx, b.cfg.RPCTimeout)
+	defer cancel()
+
+	start := time.Now()
+	err := rpc(ctx)
+	b.stats.Call(name, start, err)
+	return err
+}
@@ -0,0 +1,67 @@
+package loadtest
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"math/rand"
+	"sync"
+	"time"
+
+	"github.com/GandarfHSE/go-mafia/internal/proto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+)
+
+type Config struct {
+	Addr    string
+	Creds   credentials.TransportCredentials
+	Clients int
+	// Clients started per second
+	Rate float64
+	// Chat messages sent by every client in lobby and every day
+	Chat        int
+	RPCTimeout  time.Duration
+	GameTimeout time.Duration
+}
+
+// Run spawns simulated clients against running server and waits for all of them
+func Run(cfg Config) (*Stats, error) {
+	conn, err := grpc.Dial(cfg.Addr, grpc.WithTransportCredentials(cfg.Creds))
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+	lobby := proto.NewLobbyClient(conn)
+
+	stats := CreateStats()
+	runID := time.Now().UnixNano() % 100000
+	var wg sync.WaitGroup
+	for i := 0; i < cfg.Clients; i++ {
+		b := &bot{
+			cfg:    &cfg,
+			stats:  stats,
+			lobby:  lobby,
+			player: &proto.Player{Name: fmt.Sprintf("lt%v-%v", runID, i)},
+			rnd:    rand.New(rand.NewSource(runID + int64(i))),
+		}
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			ctx, cancel := context.WithTimeout(context.Background(), cfg.GameTimeout)
+			defer cancel()
+
+			err := b.run(ctx)
+			if err != nil {
+				log.Printf("Client %v failed: %v", b.player.Name, err)
+			}
+			stats.Finish(err)
+		}()
+
+		if cfg.Rate > 0 {
+			time.Sleep(time.Duration(float64(time.Second) / cfg.Rate))
+		}
+	}
+	wg.Wait()
+	return stats, nil
+}
@@ -0,0 +1,125 @@
+package loadtest
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"sync"
+	"text/tabwriter"
+	"time"
+
+	"github.com/GandarfHSE/go-mafia/internal/utils/i18n"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type opStats struct {
+	latencies []time.Duration
+	errors    int
+	timeouts  int
+}
+
+// Stats collects latencies and outcomes of operations, it is shared by all bots
+type Stats struct {
+	mu  sync.Mutex
+	ops map[string]*opStats
+
+	started  time.Time
+	finished int
+	failed   int
+	games    int
+}
+
+func CreateStats() *Stats {
+	return &Stats{ops: make(map[string]*opStats), started: time.Now()}
+}
+
+func (s *Stats) op(name string) *opStats {
+	op, ok := s.ops[name]
+	if !ok {
+		op = &opStats{}
+		s.ops[name] = op
+	}
+	return op
+}
+
+// Observe records successful operation
+func (s *Stats) Observe(name string, d time.Duration) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	op := s.op(name)
+	op.latencies = append(op.latencies, d)
+}
+
+// Call records outcome of operation started at start
+func (s *Stats) Call(name string, start time.Time, err error) {
+	if err == nil {
+		s.Observe(name, time.Since(start))
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	op := s.op(name)
+	if status.Code(err) == codes.DeadlineExceeded || errors.Is(err, context.DeadlineExceeded) {
+		op.timeouts += 1
+	} else {
+		op.errors += 1
+	}
+}
+
+func (s *Stats) Finish(err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err != nil {
+		s.failed += 1
+	} else {
+		s.finished += 1
+	}
+}
+
+func (s *Stats) GameEnded() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.games += 1
+}
+
+func (s *Stats) Print(w io.Writer, tr *i18n.Printer) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	fmt.Fprintf(w, "%v\n\n", tr.T("loadtest.summary", time.Since(s.started).Round(time.Millisecond), s.finished, s.failed, s.games))
+
+	names := make([]string, 0)
+	for name := range s.ops {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', tabwriter.AlignRight)
+	fmt.Fprintln(tw, tr.T("loadtest.header"))
+	for _, name := range names {
+		op := s.ops[name]
+		sort.Slice(op.latencies, func(i, j int) bool {
+			return op.latencies[i] < op.latencies[j]
+		})
+		fmt.Fprintf(tw, "%v\t%v\t%v\t%v\t%v\t%v\t%v\t%v\t\n", name, len(op.latencies), op.errors, op.timeouts,
+			percentile(op.latencies, 0.5), percentile(op.latencies, 0.95), percentile(op.latencies, 0.99), percentile(op.latencies, 1))
+	}
+	tw.Flush()
+}
+
+// latencies must be sorted
+func percentile(latencies []time.Duration, p float64) string {
+	if len(latencies) == 0 {
+		return "-"
+	}
+	i := int(float64(len(latencies)-1) * p)
+	return latencies[i].Round(10 * time.Microsecond).String()
+}
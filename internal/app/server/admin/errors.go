@@ -0,0 +1,20 @@
+package server
+
+import (
+	"fmt"
+
+	"github.com/GandarfHSE/go-mafia/internal/proto"
+	"github.com/GandarfHSE/go-mafia/internal/utils/grpcutil"
+	"google.golang.org/grpc/codes"
+)
+
+var (
+	errBadToken      = grpcutil.Error(codes.Unauthenticated, proto.ErrorReason_ERROR_REASON_BAD_TOKEN, "invalid admin token")
+	errNoName        = grpcutil.Error(codes.InvalidArgument, proto.ErrorReason_ERROR_REASON_BAD_REQUEST, "player name is required")
+	errEmptyMsg      = grpcutil.Error(codes.InvalidArgument, proto.ErrorReason_ERROR_REASON_BAD_REQUEST, "announcement is empty")
+	errUnknownPlayer = grpcutil.Error(codes.NotFound, proto.ErrorReason_ERROR_REASON_UNKNOWN_PLAYER, "player not found")
+)
+
+func errUnknownGame(id string) error {
+	return grpcutil.Error(codes.NotFound, proto.ErrorReason_ERROR_REASON_UNKNOWN_GAME, fmt.Sprintf("game %v not found", id), id)
+}
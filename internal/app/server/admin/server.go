@@ -0,0 +1,113 @@
+package server
+
+import (
+	"context"
+	"crypto/subtle"
+	"log/slog"
+
+	game "github.com/GandarfHSE/go-mafia/internal/app/server/game"
+	lobby "github.com/GandarfHSE/go-mafia/internal/app/server/lobby"
+	"github.com/GandarfHSE/go-mafia/internal/proto"
+	"github.com/GandarfHSE/go-mafia/internal/utils/grpcutil"
+	"github.com/GandarfHSE/go-mafia/internal/utils/logger"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+type AdminServer struct {
+	proto.UnimplementedAdminServer
+
+	lobby *lobby.LobbyServer
+}
+
+func CreateAdminServer(lobby *lobby.LobbyServer) *AdminServer {
+	return &AdminServer{
+		lobby: lobby,
+	}
+}
+
+// Every admin RPC must carry admin credential in metadata
+func AuthInterceptor(token string) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		md, _ := metadata.FromIncomingContext(ctx)
+		values := md.Get(grpcutil.TokenHeader)
+		if len(values) != 1 || subtle.ConstantTimeCompare([]byte(values[0]), []byte(token)) != 1 {
+			slog.Warn("Unauthorized admin request", logger.Method, info.FullMethod)
+			return nil, errBadToken
+		}
+		return handler(ctx, req)
+	}
+}
+
+func (s *AdminServer) List(_ context.Context, _ *proto.Empty) (*proto.AdminListResponse, error) {
+	games := make([]*proto.GameInfo, 0)
+	for _, g := range s.lobby.Games() {
+		games = append(games, g.Info())
+	}
+	return &proto.AdminListResponse{
+		Lobbies: []*proto.LobbyInfo{s.lobby.LobbyInfo()},
+		Games:   games,
+	}, nil
+}
+
+func (s *AdminServer) InspectGame(_ context.Context, req *proto.GameIdRequest) (*proto.AdminGameState, error) {
+	g, ok := s.lobby.Game(req.GameId)
+	if !ok {
+		return nil, errUnknownGame(req.GameId)
+	}
+	return g.AdminState(), nil
+}
+
+func (s *AdminServer) EndGame(_ context.Context, req *proto.GameIdRequest) (*proto.Empty, error) {
+	g, ok := s.lobby.Game(req.GameId)
+	if !ok {
+		return nil, errUnknownGame(req.GameId)
+	}
+	if err := g.End(); err != nil {
+		return nil, game.StatusError(err)
+	}
+	return &proto.Empty{}, nil
+}
+
+func (s *AdminServer) PauseGame(_ context.Context, req *proto.PauseGameRequest) (*proto.Empty, error) {
+	g, ok := s.lobby.Game(req.GameId)
+	if !ok {
+		return nil, errUnknownGame(req.GameId)
+	}
+	g.SetPaused(req.Paused)
+	return &proto.Empty{}, nil
+}
+
+func (s *AdminServer) Kick(_ context.Context, req *proto.KickRequest) (*proto.Empty, error) {
+	if req.Name == "" {
+		return nil, errNoName
+	}
+	slog.Info("Kick player", logger.Player, req.Name, "ban", req.Ban)
+	if !s.lobby.Kick(req.Name, req.Ban) && !req.Ban {
+		return nil, errUnknownPlayer
+	}
+	return &proto.Empty{}, nil
+}
+
+func (s *AdminServer) Foul(_ context.Context, req *proto.FoulRequest) (*proto.Empty, error) {
+	if req.Name == "" {
+		return nil, errNoName
+	}
+	slog.Info("Foul player", logger.Player, req.Name)
+	found, err := s.lobby.Foul(req.Name)
+	if !found {
+		return nil, errUnknownPlayer
+	}
+	if err != nil {
+		return nil, game.StatusError(err)
+	}
+	return &proto.Empty{}, nil
+}
+
+func (s *AdminServer) Announce(_ context.Context, req *proto.AnnounceRequest) (*proto.Empty, error) {
+	if req.Msg == "" {
+		return nil, errEmptyMsg
+	}
+	s.lobby.Announce(req.Msg)
+	return &proto.Empty{}, nil
+}
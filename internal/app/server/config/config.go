@@ -0,0 +1,130 @@
+package config
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/GandarfHSE/go-mafia/internal/utils/fanout"
+)
+
+const (
+	RulesetClassic string = "classic"
+	// Competitive rules: speeches, fouls, Don, meeting of mafia and the best move
+	RulesetSport string = "sport"
+)
+
+type Config struct {
+	LobbyAddr string
+
+	AdminAddr  string
+	AdminToken string
+
+	MetricsAddr string
+
+	LogLevel  string
+	LogFormat string
+
+	DrainTimeout time.Duration
+	DataDir      string
+
+	EventQueueSize   int
+	EventQueuePolicy fanout.Policy
+	ChatQueueSize    int
+
+	LastWords      time.Duration
+	NightLastWords time.Duration
+
+	Nominations     bool
+	SeatOrderVoting bool
+
+	Ruleset     string
+	SpeechTime  time.Duration
+	MeetingTime time.Duration
+
+	TLSCert              string
+	TLSKey               string
+	TLSClientCA          string
+	TLSRequireClientCert bool
+}
+
+// Flags take precedence over env, env takes precedence over defaults
+func Load() *Config {
+	cfg := &Config{}
+
+	flag.StringVar(&cfg.LobbyAddr, "addr", envOr("MAFIA_ADDR", ":8085"), "lobby server address")
+	flag.StringVar(&cfg.AdminAddr, "admin-addr", envOr("MAFIA_ADMIN_ADDR", ":8086"), "admin server address")
+	flag.StringVar(&cfg.AdminToken, "admin-token", envOr("MAFIA_ADMIN_TOKEN", ""), "admin credential, admin service is disabled if empty")
+	flag.StringVar(&cfg.MetricsAddr, "metrics-addr", envOr("MAFIA_METRICS_ADDR", ":2112"), "prometheus metrics address, metrics are disabled if empty")
+	flag.StringVar(&cfg.LogLevel, "log-level", envOr("MAFIA_LOG_LEVEL", "info"), "log level: debug, info, warn or error")
+	flag.StringVar(&cfg.LogFormat, "log-format", envOr("MAFIA_LOG_FORMAT", "text"), "log format: text or json")
+	flag.DurationVar(&cfg.DrainTimeout, "drain-timeout", envDurationOr("MAFIA_DRAIN_TIMEOUT", 0), "how long running games may continue after shutdown signal")
+	flag.StringVar(&cfg.DataDir, "data-dir", envOr("MAFIA_DATA_DIR", ""), "directory for persistent server state, nothing is persisted if empty")
+	flag.StringVar(&cfg.TLSCert, "tls-cert", envOr("MAFIA_TLS_CERT", ""), "server certificate, TLS is disabled if empty")
+	flag.StringVar(&cfg.TLSKey, "tls-key", envOr("MAFIA_TLS_KEY", ""), "server certificate key")
+	flag.StringVar(&cfg.TLSClientCA, "tls-client-ca", envOr("MAFIA_TLS_CLIENT_CA", ""), "CA for client certificates, enables mTLS player identity")
+	flag.BoolVar(&cfg.TLSRequireClientCert, "tls-require-client-cert", envOr("MAFIA_TLS_REQUIRE_CLIENT_CERT", "") == "true", "reject clients without certificate")
+	flag.IntVar(&cfg.EventQueueSize, "event-queue-size", envIntOr("MAFIA_EVENT_QUEUE_SIZE", 64), "how many undelivered game events are kept per player")
+	policy := flag.String("event-queue-policy", envOr("MAFIA_EVENT_QUEUE_POLICY", string(fanout.DropOldest)), "what to do when player's event queue is full: drop-oldest, disconnect or spill")
+	flag.DurationVar(&cfg.LastWords, "last-words", envDurationOr("MAFIA_LAST_WORDS", 0), "time for last words of jailed player, disabled if 0")
+	flag.DurationVar(&cfg.NightLastWords, "night-last-words", envDurationOr("MAFIA_NIGHT_LAST_WORDS", 0), "time for last words of night victims at dawn, disabled if 0")
+	flag.BoolVar(&cfg.Nominations, "nominations", envOr("MAFIA_NOMINATIONS", "") == "true", "day starts with nominations, then players vote for nominees only")
+	voteOrder := flag.String("vote-order", envOr("MAFIA_VOTE_ORDER", "simultaneous"), "how nominees are voted for: simultaneous or seat")
+	flag.StringVar(&cfg.Ruleset, "ruleset", envOr("MAFIA_RULESET", RulesetClassic), "game rules: classic or sport")
+	flag.DurationVar(&cfg.SpeechTime, "speech-time", envDurationOr("MAFIA_SPEECH_TIME", time.Minute), "time for a speech in sport games, unlimited if 0")
+	flag.DurationVar(&cfg.MeetingTime, "meeting-time", envDurationOr("MAFIA_MEETING_TIME", time.Minute), "time for the meeting of mafia in sport games, unlimited if 0")
+	flag.IntVar(&cfg.ChatQueueSize, "chat-queue-size", envIntOr("MAFIA_CHAT_QUEUE_SIZE", 256), "how many undelivered chat messages are kept per player")
+	flag.Parse()
+
+	var err error
+	if cfg.EventQueuePolicy, err = fanout.ParsePolicy(*policy); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(2)
+	}
+	switch *voteOrder {
+	case "simultaneous":
+	case "seat":
+		cfg.SeatOrderVoting = true
+	default:
+		fmt.Fprintf(os.Stderr, "unknown vote order %q, expected simultaneous or seat\n", *voteOrder)
+		os.Exit(2)
+	}
+	if cfg.Ruleset != RulesetClassic && cfg.Ruleset != RulesetSport {
+		fmt.Fprintf(os.Stderr, "unknown ruleset %q, expected classic or sport\n", cfg.Ruleset)
+		os.Exit(2)
+	}
+	// only nominees are voted for in seat order, sport games always have nominations
+	if cfg.SeatOrderVoting && !cfg.Nominations && cfg.Ruleset != RulesetSport {
+		fmt.Fprintln(os.Stderr, "vote order seat requires -nominations")
+		os.Exit(2)
+	}
+
+	return cfg
+}
+
+func envOr(key string, def string) string {
+	if v, ok := os.LookupEnv(key); ok {
+		return v
+	}
+	return def
+}
+
+func envIntOr(key string, def int) int {
+	if v, ok := os.LookupEnv(key); ok {
+		if n, err := strconv.Atoi(v); err == nil {
+			return n
+		}
+	}
+	return def
+}
+
+func envDurationOr(key string, def time.Duration) time.Duration {
+	if v, ok := os.LookupEnv(key); ok {
+		if d, err := time.ParseDuration(v); err == nil {
+			return d
+		}
+	}
+	return def
+}
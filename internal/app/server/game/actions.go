@@ -0,0 +1,96 @@
+package server
+
+import (
+	"context"
+
+	"github.com/GandarfHSE/go-mafia/internal/engine"
+	"github.com/GandarfHSE/go-mafia/internal/proto"
+)
+
+// AvailableActions lists what the caller can do right now, nothing while game is paused
+func (s *GameServer) AvailableActions(_ context.Context, req *proto.StateRequest) (*proto.AvailableActionsResponse, error) {
+	resp := &proto.AvailableActionsResponse{}
+	err := s.do(func() error {
+		pid, err := s.validPlayer(req.Player)
+		if err != nil {
+			return err
+		}
+		resp.Phase = protoPhase(s.state.Phase)
+		if s.paused {
+			return nil
+		}
+		for _, o := range s.state.Options(pid) {
+			resp.Actions = append(resp.Actions, actionInfo(o))
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, StatusError(err)
+	}
+	return resp, nil
+}
+
+// PerformAction runs any action listed by AvailableActions
+func (s *GameServer) PerformAction(_ context.Context, req *proto.PerformActionRequest) (*proto.PerformActionResponse, error) {
+	if req.ActionId == "" {
+		return nil, errNoAction
+	}
+	resp := &proto.PerformActionResponse{ActionId: req.ActionId, Outcome: proto.ActionOutcome_ACTION_OUTCOME_APPLIED}
+	if engine.IsNightAction(req.ActionId) {
+		resp.Outcome = proto.ActionOutcome_ACTION_OUTCOME_SCHEDULED
+	}
+
+	err := s.do(func() error {
+		pid, err := s.validPlayer(req.Player)
+		if err != nil {
+			return err
+		}
+		for _, o := range s.state.Options(pid) {
+			if o.Kind == req.ActionId {
+				resp.Confirmation = o.Confirmation
+			}
+		}
+
+		if err := s.perform(pid, req.ActionId, req.Targets); err != nil {
+			return err
+		}
+		for _, o := range s.state.Options(pid) {
+			resp.Actions = append(resp.Actions, actionInfo(o))
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, StatusError(err)
+	}
+	return resp, nil
+}
+
+// perform is shared by PerformAction and deprecated action RPCs
+func (s *GameServer) perform(pid int, kind string, targets []int32) error {
+	if s.paused {
+		return errPaused
+	}
+	act := engine.Act{Kind: kind, Actor: pid}
+	for _, t := range targets {
+		act.Targets = append(act.Targets, int(t))
+	}
+	return s.apply(act)
+}
+
+func actionInfo(o engine.Option) *proto.ActionInfo {
+	info := &proto.ActionInfo{
+		Id:           o.Kind,
+		Title:        o.Title,
+		Arg:          proto.ArgType_ARG_TYPE_PLAYER,
+		CanSkip:      o.CanSkip,
+		Confirmation: o.Confirmation,
+		MaxTargets:   int32(o.MaxTargets),
+	}
+	if o.NoTarget {
+		info.Arg = proto.ArgType_ARG_TYPE_NONE
+	}
+	for _, pid := range o.Targets {
+		info.Targets = append(info.Targets, int32(pid))
+	}
+	return info
+}
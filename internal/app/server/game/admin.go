@@ -0,0 +1,113 @@
+package server
+
+import (
+	"github.com/GandarfHSE/go-mafia/internal/engine"
+	"github.com/GandarfHSE/go-mafia/internal/proto"
+	"github.com/GandarfHSE/go-mafia/internal/utils/i18n"
+	"github.com/GandarfHSE/go-mafia/internal/utils/player"
+)
+
+// Operator-facing methods, used by admin service
+
+func (s *GameServer) ID() string {
+	return s.id
+}
+
+func (s *GameServer) Info() *proto.GameInfo {
+	info := &proto.GameInfo{Id: s.id, Addr: s.addr}
+	s.do(func() error {
+		info.PlayerNames = s.state.Names()
+		info.Alive = int32(s.state.AliveCount())
+		info.Paused = s.paused
+		return nil
+	})
+	return info
+}
+
+func (s *GameServer) AdminState() *proto.AdminGameState {
+	st := &proto.AdminGameState{Id: s.id, Phase: engine.PhaseEnded.String()}
+	s.do(func() error {
+		st.Phase = s.state.Phase.String()
+		st.Paused = s.paused
+		for i, p := range s.state.Players {
+			// -2 - not voted yet, -1 - skipped voting
+			st.Players = append(st.Players, &proto.PlayerInfo{
+				Name:  p.Name,
+				Addr:  s.players[i].Addr,
+				Role:  p.Role,
+				Alive: p.Alive,
+				Vote:  int32(s.state.Votes[i]),
+				Fouls: int32(s.state.Fouls[i]),
+			})
+		}
+		for _, cnt := range s.state.VotesCount {
+			st.VotesCount = append(st.VotesCount, int32(cnt))
+		}
+		return nil
+	})
+	return st
+}
+
+func (s *GameServer) End() error {
+	return s.do(s.forceEnd)
+}
+
+func (s *GameServer) SetPaused(paused bool) {
+	s.do(func() error {
+		if s.paused == paused {
+			return nil
+		}
+
+		s.paused = paused
+		s.checkpoint()
+		if paused {
+			s.freezeTimer()
+			s.broadcastMsgFromServer("chat.paused")
+		} else {
+			s.broadcastMsgFromServer("chat.resumed")
+			s.resumeTimer()
+		}
+		return nil
+	})
+}
+
+func (s *GameServer) Kick(name string) bool {
+	found := false
+	s.do(func() error {
+		pid := s.getPid(&proto.Player{Name: name})
+		if pid == -1 {
+			return nil
+		}
+
+		found = true
+		s.disconnect(name)
+		s.players[pid].SendMsg(player.ServerSender, i18n.Msg("chat.kicked_you_game"))
+		s.broadcastMsgFromServer("chat.kicked_game", name)
+		s.leave(pid)
+		// events of the kick are delivered, then the stream ends
+		s.events[pid].Close()
+		return nil
+	})
+	return found
+}
+
+// Foul gives a foul to the player, false if there is no such player in the game
+func (s *GameServer) Foul(name string) (bool, error) {
+	found := false
+	err := s.do(func() error {
+		pid := s.getPid(&proto.Player{Name: name})
+		if pid == -1 {
+			return nil
+		}
+		found = true
+		return s.apply(engine.Foul{Player: pid})
+	})
+	return found, err
+}
+
+func (s *GameServer) Announce(msg string) {
+	s.do(func() error {
+		s.broadcastMsgFromServer("chat.announce", msg)
+		return nil
+	})
+}
@@ -0,0 +1,79 @@
+package server
+
+import (
+	"errors"
+
+	"github.com/GandarfHSE/go-mafia/internal/engine"
+	"github.com/GandarfHSE/go-mafia/internal/proto"
+	"github.com/GandarfHSE/go-mafia/internal/utils/grpcutil"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var (
+	errPaused    = errors.New("game is paused")
+	errNoPlayer  = grpcutil.Error(codes.InvalidArgument, proto.ErrorReason_ERROR_REASON_BAD_REQUEST, "player is required")
+	errNoAction  = grpcutil.Error(codes.InvalidArgument, proto.ErrorReason_ERROR_REASON_BAD_REQUEST, "action_id is required")
+	errEmptyMsg  = grpcutil.Error(codes.InvalidArgument, proto.ErrorReason_ERROR_REASON_BAD_REQUEST, "msg is empty")
+	errLongMsg   = grpcutil.Error(codes.InvalidArgument, proto.ErrorReason_ERROR_REASON_BAD_REQUEST, "msg is too long")
+	errQueueFull = grpcutil.Error(codes.ResourceExhausted, proto.ErrorReason_ERROR_REASON_TOO_SLOW, "too many undelivered events, resubscribe")
+	errExited    = grpcutil.Error(codes.PermissionDenied, proto.ErrorReason_ERROR_REASON_DEAD_PLAYER, "player has left the game")
+)
+
+const maxMessageLen int = 1000
+
+var ruleErrors = []struct {
+	err    error
+	code   codes.Code
+	reason proto.ErrorReason
+}{
+	{engine.ErrUnknownPlayer, codes.NotFound, proto.ErrorReason_ERROR_REASON_UNKNOWN_PLAYER},
+	{engine.ErrDeadPlayer, codes.PermissionDenied, proto.ErrorReason_ERROR_REASON_DEAD_PLAYER},
+	{engine.ErrWrongRole, codes.PermissionDenied, proto.ErrorReason_ERROR_REASON_WRONG_ROLE},
+	{engine.ErrWrongPhase, codes.FailedPrecondition, proto.ErrorReason_ERROR_REASON_WRONG_PHASE},
+	{engine.ErrUnknownAction, codes.InvalidArgument, proto.ErrorReason_ERROR_REASON_UNKNOWN_ACTION},
+	{engine.ErrTargetsCount, codes.InvalidArgument, proto.ErrorReason_ERROR_REASON_WRONG_TARGET},
+	{engine.ErrWrongTarget, codes.InvalidArgument, proto.ErrorReason_ERROR_REASON_WRONG_TARGET},
+	{engine.ErrBestMoveSize, codes.InvalidArgument, proto.ErrorReason_ERROR_REASON_WRONG_TARGET},
+	{engine.ErrTargetDead, codes.InvalidArgument, proto.ErrorReason_ERROR_REASON_TARGET_DEAD},
+	{engine.ErrAlreadyVoted, codes.FailedPrecondition, proto.ErrorReason_ERROR_REASON_ALREADY_ACTED},
+	{engine.ErrAlreadyNominated, codes.FailedPrecondition, proto.ErrorReason_ERROR_REASON_ALREADY_ACTED},
+	{engine.ErrNotYourTurn, codes.FailedPrecondition, proto.ErrorReason_ERROR_REASON_NOT_YOUR_TURN},
+	{engine.ErrAlreadyKilled, codes.FailedPrecondition, proto.ErrorReason_ERROR_REASON_ALREADY_ACTED},
+	{engine.ErrAlreadyChecked, codes.FailedPrecondition, proto.ErrorReason_ERROR_REASON_ALREADY_ACTED},
+	{engine.ErrAlreadyStarted, codes.FailedPrecondition, proto.ErrorReason_ERROR_REASON_WRONG_PHASE},
+	{engine.ErrGameOver, codes.FailedPrecondition, proto.ErrorReason_ERROR_REASON_GAME_OVER},
+	{errPaused, codes.FailedPrecondition, proto.ErrorReason_ERROR_REASON_GAME_PAUSED},
+}
+
+// StatusError maps rules errors to gRPC statuses with reason details.
+// Statuses are returned as is, unknown errors become Internal.
+func StatusError(err error) error {
+	if err == nil {
+		return nil
+	}
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+	for _, e := range ruleErrors {
+		if errors.Is(err, e.err) {
+			return grpcutil.Error(e.code, e.reason, err.Error())
+		}
+	}
+	return status.Error(codes.Internal, err.Error())
+}
+
+// validPlayer checks that request names a player who is still in this game and returns pid
+func (s *GameServer) validPlayer(pl *proto.Player) (int, error) {
+	if pl == nil || pl.Name == "" {
+		return -1, errNoPlayer
+	}
+	pid := s.getPid(pl)
+	if pid == -1 {
+		return -1, engine.ErrUnknownPlayer
+	}
+	if s.exited[pl.Name] {
+		return -1, errExited
+	}
+	return pid, nil
+}
@@ -0,0 +1,77 @@
+package server
+
+import (
+	"time"
+
+	"github.com/GandarfHSE/go-mafia/internal/proto"
+	pb "google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func phaseChangedEvent(phase proto.Phase, day int, deadline time.Time) *proto.GameEvent {
+	ev := &proto.PhaseChanged{Phase: phase, Day: int32(day)}
+	if !deadline.IsZero() {
+		ev.Deadline = timestamppb.New(deadline)
+	}
+	return &proto.GameEvent{Type: proto.EventType_EVENT_TYPE_PHASE_CHANGED, Event: &proto.GameEvent_PhaseChanged{PhaseChanged: ev}}
+}
+
+func killedEvent(name string) *proto.GameEvent {
+	return &proto.GameEvent{Type: proto.EventType_EVENT_TYPE_PLAYER_KILLED, Event: &proto.GameEvent_Killed{Killed: &proto.PlayerKilled{Player: name}}}
+}
+
+func jailedEvent(name string) *proto.GameEvent {
+	return &proto.GameEvent{Type: proto.EventType_EVENT_TYPE_PLAYER_JAILED, Event: &proto.GameEvent_Jailed{Jailed: &proto.PlayerJailed{Player: name}}}
+}
+
+func gameEndEvent(won string, playerNames []string, roles []string) *proto.GameEvent {
+	return &proto.GameEvent{Type: proto.EventType_EVENT_TYPE_GAME_END, Event: &proto.GameEvent_End{End: &proto.GameEnd{Won: won, PlayerNames: playerNames, Roles: roles}}}
+}
+
+func youDeadEvent() *proto.GameEvent {
+	return &proto.GameEvent{Type: proto.EventType_EVENT_TYPE_YOU_DEAD, Event: &proto.GameEvent_Dead{Dead: &proto.YouDead{}}}
+}
+
+func checkResultEvent(day int, pid int, role string, action string) *proto.GameEvent {
+	return &proto.GameEvent{Type: proto.EventType_EVENT_TYPE_CHECK_RESULT, Event: &proto.GameEvent_CheckResult{CheckResult: &proto.CheckResult{Day: int32(day), Pid: int32(pid), Role: role, Action: action}}}
+}
+
+func voteTurnEvent(pid int, name string) *proto.GameEvent {
+	return &proto.GameEvent{Type: proto.EventType_EVENT_TYPE_VOTE_TURN, Event: &proto.GameEvent_VoteTurn{VoteTurn: &proto.VoteTurn{Pid: int32(pid), Player: name}}}
+}
+
+func speechTurnEvent(pid int, name string, deadline time.Time) *proto.GameEvent {
+	ev := &proto.SpeechTurn{Pid: int32(pid), Player: name}
+	if !deadline.IsZero() {
+		ev.Deadline = timestamppb.New(deadline)
+	}
+	return &proto.GameEvent{Type: proto.EventType_EVENT_TYPE_SPEECH_TURN, Event: &proto.GameEvent_SpeechTurn{SpeechTurn: ev}}
+}
+
+func shutdownEvent(drain time.Duration) *proto.GameEvent {
+	return &proto.GameEvent{Type: proto.EventType_EVENT_TYPE_SERVER_SHUTDOWN, Event: &proto.GameEvent_Shutdown{Shutdown: &proto.ServerShutdown{DrainSeconds: int32(drain.Seconds())}}}
+}
+
+// sendEvent stamps event with subscriber's next sequence number and queues it.
+// Events are numbered per player, so every subscriber can detect missed or duplicated events.
+// The game never waits for subscribers: full queue is handled by its policy and client resyncs.
+func (s *GameServer) sendEvent(pid int, e *proto.GameEvent) {
+	s.eventSeqs[pid] += 1
+	stamped := pb.Clone(e).(*proto.GameEvent)
+	stamped.Version = proto.EventSchemaVersion
+	stamped.Seq = s.eventSeqs[pid]
+	stamped.Time = timestamppb.Now()
+	s.events[pid].Push(stamped)
+}
+
+// eventCodec stores events in spill files of subscriber queues
+type eventCodec struct{}
+
+func (eventCodec) Marshal(e *proto.GameEvent) ([]byte, error) {
+	return pb.Marshal(e)
+}
+
+func (eventCodec) Unmarshal(data []byte) (*proto.GameEvent, error) {
+	e := &proto.GameEvent{}
+	return e, pb.Unmarshal(data, e)
+}
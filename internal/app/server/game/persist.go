@@ -0,0 +1,177 @@
+package server
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"log/slog"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/GandarfHSE/go-mafia/internal/app/server/metrics"
+	"github.com/GandarfHSE/go-mafia/internal/engine"
+	"github.com/GandarfHSE/go-mafia/internal/utils/logger"
+	"github.com/GandarfHSE/go-mafia/internal/utils/player"
+)
+
+const (
+	gamesDir        string = "games"
+	snapshotVersion int    = 4
+)
+
+type playerSnapshot struct {
+	Name     string `json:"name"`
+	Addr     string `json:"addr"`
+	Exited   bool   `json:"exited"`
+	EventSeq uint64 `json:"event_seq"`
+}
+
+type gameSnapshot struct {
+	Version int              `json:"version"`
+	ID      string           `json:"id"`
+	Addr    string           `json:"addr"`
+	Paused  bool             `json:"paused"`
+	Players []playerSnapshot `json:"players"`
+	State   *engine.State    `json:"state"`
+}
+
+func snapshotPath(dataDir string, id string) string {
+	return filepath.Join(dataDir, gamesDir, id+".json")
+}
+
+// checkpoint saves game to data dir, so it can be restored after crash
+func (s *GameServer) checkpoint() {
+	if s.opts.DataDir == "" || s.closed || s.state.Phase == engine.PhaseEnded {
+		return
+	}
+
+	snap := gameSnapshot{
+		Version: snapshotVersion,
+		ID:      s.id,
+		Addr:    s.addr,
+		Paused:  s.paused,
+		State:   s.state,
+	}
+	for i, p := range s.players {
+		snap.Players = append(snap.Players, playerSnapshot{
+			Name:     p.Name,
+			Addr:     p.Addr,
+			Exited:   s.exited[p.Name],
+			EventSeq: s.eventSeqs[i],
+		})
+	}
+
+	data, err := json.Marshal(snap)
+	if err != nil {
+		s.logger().Error("Can't marshal game snapshot", logger.Error, err)
+		return
+	}
+
+	path := snapshotPath(s.opts.DataDir, s.id)
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		s.logger().Error("Can't create games dir", logger.Error, err)
+		return
+	}
+	// Snapshot is replaced atomically, so crash while writing doesn't corrupt it
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0o644); err != nil {
+		s.logger().Error("Can't write game snapshot", logger.Error, err)
+		return
+	}
+	if err := os.Rename(tmp, path); err != nil {
+		s.logger().Error("Can't save game snapshot", logger.Error, err)
+	}
+}
+
+func (s *GameServer) removeCheckpoint() {
+	if s.opts.DataDir == "" {
+		return
+	}
+	err := os.Remove(snapshotPath(s.opts.DataDir, s.id))
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		s.logger().Error("Can't remove game snapshot", logger.Error, err)
+	}
+}
+
+// LoadGameServers restores unfinished games saved in data dir.
+// Restored games continue from the same phase when started with Run.
+func LoadGameServers(opts Options) []*GameServer {
+	if opts.DataDir == "" {
+		return nil
+	}
+
+	entries, err := os.ReadDir(filepath.Join(opts.DataDir, gamesDir))
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		slog.Error("Can't read games dir", logger.Error, err)
+		return nil
+	}
+
+	games := make([]*GameServer, 0)
+	for _, e := range entries {
+		if e.IsDir() || !strings.HasSuffix(e.Name(), ".json") {
+			continue
+		}
+		path := filepath.Join(opts.DataDir, gamesDir, e.Name())
+		s, err := loadGameServer(opts, path)
+		if err != nil {
+			slog.Error("Can't restore game", "path", path, logger.Error, err)
+			continue
+		}
+		games = append(games, s)
+	}
+	return games
+}
+
+func loadGameServer(opts Options, path string) (*GameServer, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var snap gameSnapshot
+	if err := json.Unmarshal(data, &snap); err != nil {
+		return nil, err
+	}
+	if snap.Version != snapshotVersion {
+		return nil, fmt.Errorf("unsupported snapshot version %v", snap.Version)
+	}
+	if snap.State == nil || len(snap.Players) != len(snap.State.Players) {
+		return nil, errors.New("malformed snapshot")
+	}
+	if snap.State.Phase == engine.PhaseEnded {
+		os.Remove(path)
+		return nil, errors.New("game is already over")
+	}
+
+	players := make([]player.Player, 0)
+	for _, p := range snap.Players {
+		conn, err := net.Dial("udp", p.Addr)
+		if err != nil {
+			for _, pl := range players {
+				pl.Close()
+			}
+			return nil, err
+		}
+		players = append(players, player.CreatePlayer(p.Name, p.Addr, conn, opts.Chat))
+	}
+
+	s := createGameServer(snap.ID, snap.Addr, players, snap.State, opts)
+	s.restored = snap.State.Phase != engine.PhaseNotStarted
+	s.paused = snap.Paused
+	for i, p := range snap.Players {
+		s.eventSeqs[i] = p.EventSeq
+		if p.Exited {
+			s.exited[p.Name] = true
+		} else {
+			metrics.ConnectedPlayers.Inc()
+		}
+	}
+
+	s.logger().Info("Game restored", "addr", s.addr)
+	return s, nil
+}
@@ -4,125 +4,517 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"log"
-	"sync"
-
+	"io"
+	"log/slog"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/GandarfHSE/go-mafia/internal/app/server/metrics"
+	"github.com/GandarfHSE/go-mafia/internal/engine"
 	"github.com/GandarfHSE/go-mafia/internal/proto"
 	"github.com/GandarfHSE/go-mafia/internal/utils/algo"
+	"github.com/GandarfHSE/go-mafia/internal/utils/fanout"
+	"github.com/GandarfHSE/go-mafia/internal/utils/i18n"
+	"github.com/GandarfHSE/go-mafia/internal/utils/logger"
 	"github.com/GandarfHSE/go-mafia/internal/utils/player"
 )
 
+// Options are shared by all games started by lobby
+type Options struct {
+	// If set, game is checkpointed there on every transition
+	DataDir string
+	// Every player gets own bounded event queue, so slow subscriber doesn't stall the game
+	Events fanout.Options
+	Chat   fanout.Options
+	// Time for last words of jailed player and of night victims, 0 disables them
+	LastWords      time.Duration
+	NightLastWords time.Duration
+	// Day starts with nominations, nominees may be voted for in seat order
+	Nominations     bool
+	SeatOrderVoting bool
+	// Sport ruleset: timed speeches, fouls, Don, meeting of mafia and the best move.
+	// Speech and meeting without time limit end only when players pass.
+	Sport       bool
+	SpeechTime  time.Duration
+	MeetingTime time.Duration
+}
+
+// GameServer adapts rules engine to gRPC: it owns connections of players,
+// applies their actions to the engine state and delivers emitted events.
+// Game state is owned by the goroutine running Run, RPC handlers submit commands to its mailbox.
 type GameServer struct {
 	proto.UnimplementedGameServer
 
+	id      string
+	addr    string
+	opts    Options
+	log     *slog.Logger
 	players []player.Player
-	mu      sync.Mutex
-
-	state  *GameState
-	closed bool
+	events  []*fanout.Queue[*proto.GameEvent]
+
+	mailbox chan func()
+	stopped chan struct{}
+
+	eventSeqs  []uint64
+	state      *engine.State
+	phase      string
+	phaseStart time.Time
+	closed     bool
+	paused     bool
+	ended      bool
+	shutdown   bool
+	restored   bool
+	exited     map[string]bool
+	// Events are logged at the phase they happened in, state is already the next one while they are published
+	eventsAt *phaseAt
+
+	// Timer ends timed phase with its action, it is frozen while game is paused
+	timer     *time.Timer
+	timeout   engine.Action
+	deadline  time.Time
+	remaining time.Duration
 }
 
-func CreateGameServer(Players []player.Player) *GameServer {
-	roles := algo.Shuffle([]string{
-		"civ", "civ", "maf", "com",
-	})
-	if len(roles) != len(Players) {
-		log.Fatal("Число ролей не совпадает с числом игроков!")
+// Game takes ownership of players, they are closed by Close
+func CreateGameServer(id string, addr string, Players []player.Player, opts Options) *GameServer {
+	roles := []string{engine.RoleCivilian, engine.RoleCivilian, engine.RoleMafia, engine.RoleCommissar}
+	if opts.Sport {
+		roles = sportRoles(len(Players))
+	}
+	roles = algo.Shuffle(roles)
+	names := make([]string, 0)
+	for _, p := range Players {
+		names = append(names, p.Name)
 	}
-	for i, role := range roles {
-		Players[i].Role = role
+	state, err := engine.NewGame(names, roles)
+	if err != nil {
+		slog.Error("Can't create game", logger.GameID, id, logger.Error, err)
+		os.Exit(1)
+	}
+	state.Settings = engine.Settings{
+		Nominations: opts.Nominations,
+	}
+	if opts.Sport {
+		state.Settings = engine.SportSettings()
 	}
+	state.Settings.LastWords = opts.LastWords > 0
+	state.Settings.NightLastWords = opts.NightLastWords > 0
+	state.Settings.SeatOrderVoting = opts.SeatOrderVoting
 
 	playersCopy := make([]player.Player, len(Players))
 	copy(playersCopy, Players)
-	s := &GameServer{
-		players: playersCopy,
-		state:   nil,
-	}
-	s.state = CreateGameState(s)
-	return s
+	return createGameServer(id, addr, playersCopy, state, opts)
 }
 
-func (s *GameServer) CheckVictory() bool {
-	mafAlive := 0
-	civAlive := 0
-
-	for _, p := range s.players {
-		if p.Alive {
-			if p.Role == "maf" {
-				mafAlive += 1
-			} else {
-				civAlive += 1
-			}
-		}
+func createGameServer(id string, addr string, players []player.Player, state *engine.State, opts Options) *GameServer {
+	events := make([]*fanout.Queue[*proto.GameEvent], len(players))
+	for i := range events {
+		events[i] = fanout.CreateQueue[*proto.GameEvent](opts.Events, eventCodec{})
+	}
+	return &GameServer{
+		id:      id,
+		addr:    addr,
+		opts:    opts,
+		log:     slog.Default().With(logger.GameID, id),
+		players: players,
+		events:  events,
+		state:   state,
+		mailbox: make(chan func()),
+		stopped: make(chan struct{}),
+		exited:  make(map[string]bool),
+
+		eventSeqs: make([]uint64, len(players)),
 	}
+}
 
-	if mafAlive == 0 {
-		s.broadcastEvent(&proto.GameEvent{Type: "end", Event: &proto.GameEvent_End{End: &proto.GameEnd{Won: "civ", PlayerNames: s.getPlayerNames(), Roles: s.getPlayerRoles()}}})
-		return true
+// sportRoles gives every third player to mafia led by the Don, 10 players get 2 mafia and the Don
+func sportRoles(players int) []string {
+	roles := []string{engine.RoleDon, engine.RoleCommissar}
+	for i := 1; i < players/3; i++ {
+		roles = append(roles, engine.RoleMafia)
 	}
-	if mafAlive >= civAlive {
-		s.broadcastEvent(&proto.GameEvent{Type: "end", Event: &proto.GameEvent_End{End: &proto.GameEnd{Won: "maf", PlayerNames: s.getPlayerNames(), Roles: s.getPlayerRoles()}}})
-		return true
+	for len(roles) < players {
+		roles = append(roles, engine.RoleCivilian)
 	}
-	return false
+	return roles
+}
+
+func (s *GameServer) Addr() string {
+	return s.addr
 }
 
+// Run starts the game and processes commands until it is over or server shuts down.
+// Restored game continues from the saved phase.
 func (s *GameServer) Run() {
-	for {
-		s.broadcastMsgFromServer("Новый день - новое голосование!\n")
-		s.state.SetupNewDay()
-		jailed := <-s.state.VoteChan
-		if s.state.VotesCount[jailed] > 0 {
-			s.killPlayer(jailed)
-			s.broadcastEvent(&proto.GameEvent{Type: "jail", Event: &proto.GameEvent_Jailed{Jailed: &proto.PlayerJailed{Player: s.players[jailed].Name}}})
+	defer close(s.stopped)
+
+	if s.restored {
+		if s.paused {
+			s.broadcastMsgFromServer("chat.restored_paused")
 		} else {
-			s.broadcastMsgFromServer("Сегодня виновных не нашлось, попробуем завтра...")
+			s.broadcastMsgFromServer("chat.restored")
 		}
-		if s.CheckVictory() {
-			return
+		s.observePhase(s.state.Phase.String())
+		s.startTimer()
+		s.broadcastEvent(phaseChangedEvent(protoPhase(s.state.Phase), s.state.Day, s.deadline))
+		if speaker := s.state.Speaker(); speaker != -1 {
+			s.broadcastEvent(speechTurnEvent(speaker, s.players[speaker].Name, s.deadline))
 		}
-		s.broadcastEvent(&proto.GameEvent{Type: "day", Event: &proto.GameEvent_Day{Day: &proto.DayChange{}}})
-
-		s.broadcastMsgFromServer("Город засыпает...\n")
-		s.state.SetupNewNight()
-		killed := <-s.state.KillChan
-		<-s.state.CheckChan
-		s.killPlayer(killed)
-		s.broadcastEvent(&proto.GameEvent{Type: "kill", Event: &proto.GameEvent_Killed{Killed: &proto.PlayerKilled{Player: s.players[killed].Name}}})
-		if s.CheckVictory() {
-			return
+	} else if err := s.apply(engine.Start{}); err != nil {
+		s.logger().Error("Can't start game", logger.Error, err)
+		return
+	}
+
+	for !s.ended && !s.shutdown {
+		cmd := <-s.mailbox
+		cmd()
+	}
+}
+
+// do runs fn in the game goroutine and returns its error.
+// After the game is over commands are not executed anymore.
+func (s *GameServer) do(fn func() error) error {
+	var err error
+	done := make(chan struct{})
+	cmd := func() {
+		err = fn()
+		close(done)
+	}
+
+	select {
+	case s.mailbox <- cmd:
+	case <-s.stopped:
+		return engine.ErrGameOver
+	}
+	<-done
+	return err
+}
+
+// apply changes game state and delivers emitted events
+func (s *GameServer) apply(a engine.Action) error {
+	at := &phaseAt{phase: s.state.Phase, day: s.state.Day}
+	next, events, err := engine.Apply(s.state, a)
+	if err != nil {
+		return err
+	}
+	s.state = next
+	s.checkpoint()
+	s.eventsAt = at
+	s.publish(events)
+	s.eventsAt = nil
+	return nil
+}
+
+func (s *GameServer) publish(events []engine.Event) {
+	for _, e := range events {
+		switch e := e.(type) {
+		case engine.PhaseChanged:
+			if s.eventsAt != nil {
+				s.eventsAt.phase, s.eventsAt.day = e.Phase, e.Day
+			}
+			s.observePhase(e.Phase.String())
+			switch e.Phase {
+			case engine.PhaseDay:
+				s.broadcastMsgFromServer("chat.day")
+				s.logger().Info("Day started")
+			case engine.PhaseNight:
+				s.broadcastMsgFromServer("chat.night")
+				s.logger().Info("Night started")
+			case engine.PhaseVoting:
+				s.broadcastMsgFromServer("chat.voting", s.nomineeNames())
+				s.logger().Info("Voting started")
+			case engine.PhaseLastWords:
+				s.broadcastMsgFromServer("chat.last_words", s.speakerNames())
+				s.logger().Info("Last words started")
+				s.startTimer()
+			case engine.PhaseMeeting:
+				s.broadcastMsgFromServer("chat.meeting")
+				s.logger().Info("Meeting started")
+				s.startTimer()
+			}
+			s.broadcastEvent(phaseChangedEvent(protoPhase(e.Phase), e.Day, s.deadline))
+		case engine.SpeechStarted:
+			s.stopTimer()
+			s.startTimer()
+			s.broadcastEvent(speechTurnEvent(e.Player, s.players[e.Player].Name, s.deadline))
+		case engine.Fouled:
+			s.broadcastMsgFromServer("chat.foul", e.Player+1, e.Count, s.state.Settings.FoulLimit)
+		case engine.PlayerRemoved:
+			s.logger().Info("Player removed for fouls", logger.Player, s.players[e.Player].Name)
+			s.broadcastMsgFromServer("chat.removed", e.Player+1)
+		case engine.BestMoveMade:
+			s.broadcastMsgFromServer("chat.best_move", e.Player+1, seatNumbers(e.Targets))
+		case engine.BestMoveScored:
+			s.broadcastMsgFromServer("chat.best_move_result", e.Player+1, e.Guessed)
+		case engine.Voted:
+			if e.Target != engine.SkipVote {
+				s.broadcastMsgFromServer("chat.voted", e.Voter+1, e.Target+1)
+			} else {
+				s.broadcastMsgFromServer("chat.vote_skipped", e.Voter+1)
+			}
+		case engine.Nominated:
+			if e.Target != engine.SkipVote {
+				s.broadcastMsgFromServer("chat.nominated", e.Nominator+1, e.Target+1)
+			} else {
+				s.broadcastMsgFromServer("chat.nomination_skipped", e.Nominator+1)
+			}
+		case engine.VoteTurn:
+			s.broadcastEvent(voteTurnEvent(e.Player, s.players[e.Player].Name))
+		case engine.VoteReset:
+			// seat order voting announces the turn itself
+			if !s.state.Settings.SeatOrderVoting {
+				s.sendEvent(e.Voter, voteTurnEvent(e.Voter, s.players[e.Voter].Name))
+			}
+		case engine.VoteResult:
+			s.broadcastMsgFromServer("chat.vote_result", e.Nominee+1, e.Votes)
+		case engine.PlayerDied:
+			s.logger().Info("Player died", logger.Player, s.players[e.Player].Name)
+			s.sendEvent(e.Player, youDeadEvent())
+		case engine.PlayerJailed:
+			s.broadcastEvent(jailedEvent(s.players[e.Player].Name))
+		case engine.NoJail:
+			s.broadcastMsgFromServer("chat.no_jail")
+		case engine.PlayerKilled:
+			s.broadcastEvent(killedEvent(s.players[e.Player].Name))
+		case engine.NoKill:
+			s.broadcastMsgFromServer("chat.no_kill")
+		case engine.Investigated:
+			s.sendEvent(e.Checker, checkResultEvent(e.Day, e.Target, e.Role, e.Kind))
+		case engine.GameEnded:
+			if e.Winner == engine.WinnerNone {
+				s.broadcastForceEnd()
+			} else {
+				s.observePhase("")
+			}
+			s.logger().Info("Game finished", "winner", e.Winner)
+			metrics.GamesFinished.WithLabelValues(e.Winner).Inc()
+			s.stopTimer()
+			s.broadcastEvent(gameEndEvent(e.Winner, s.state.Names(), s.state.Roles()))
+			s.ended = true
 		}
-		s.broadcastEvent(&proto.GameEvent{Type: "day", Event: &proto.GameEvent_Day{Day: &proto.DayChange{}}})
 	}
 }
 
+// observePhase records duration of the finished phase and starts the next one.
+// Timer of the finished phase is not needed anymore.
+func (s *GameServer) observePhase(next string) {
+	s.stopTimer()
+	if s.phase != "" {
+		metrics.PhaseDuration.WithLabelValues(s.phase).Observe(time.Since(s.phaseStart).Seconds())
+	}
+	s.phase = next
+	s.phaseStart = time.Now()
+}
+
+// schedule applies action when d is over unless phase changes before.
+// While game is paused the time is only recorded, timer starts on resume.
+func (s *GameServer) schedule(d time.Duration, a engine.Action) {
+	s.stopTimer()
+	s.timeout = a
+	if s.paused {
+		s.remaining = d
+		return
+	}
+	s.deadline = time.Now().Add(d)
+	var t *time.Timer
+	t = time.AfterFunc(d, func() {
+		s.do(func() error {
+			if s.timer != t {
+				return nil
+			}
+			a := s.timeout
+			s.stopTimer()
+			return s.applyTimeout(a)
+		})
+	})
+	s.timer = t
+}
+
+func (s *GameServer) stopTimer() {
+	if s.timer != nil {
+		s.timer.Stop()
+		s.timer = nil
+	}
+	s.timeout = nil
+	s.deadline = time.Time{}
+	s.remaining = 0
+}
+
+// freezeTimer stops the phase clock and keeps the rest of phase time
+func (s *GameServer) freezeTimer() {
+	if s.timer == nil {
+		return
+	}
+	s.timer.Stop()
+	s.timer = nil
+	s.remaining = max(time.Until(s.deadline), 0)
+	s.deadline = time.Time{}
+}
+
+// resumeTimer starts the rest of phase time again and tells players the new deadline
+func (s *GameServer) resumeTimer() {
+	if s.timeout == nil {
+		return
+	}
+	s.schedule(s.remaining, s.timeout)
+	if speaker := s.state.Speaker(); speaker != -1 {
+		s.broadcastEvent(speechTurnEvent(speaker, s.players[speaker].Name, s.deadline))
+	} else {
+		s.broadcastEvent(phaseChangedEvent(protoPhase(s.state.Phase), s.state.Day, s.deadline))
+	}
+}
+
+func (s *GameServer) applyTimeout(a engine.Action) error {
+	err := s.apply(a)
+	if err != nil && !errors.Is(err, engine.ErrGameOver) {
+		s.logger().Warn("Can't finish timed phase", logger.Error, err)
+	}
+	return nil
+}
+
+// startTimer limits time of the current phase or speech, untimed ones wait for players
+func (s *GameServer) startTimer() {
+	switch {
+	case s.state.Phase == engine.PhaseLastWords:
+		s.schedule(s.lastWordsTime(), engine.EndLastWords{})
+	case s.state.Phase == engine.PhaseMeeting && s.opts.MeetingTime > 0:
+		s.schedule(s.opts.MeetingTime, engine.EndMeeting{})
+	case s.state.Speaker() != -1 && s.opts.SpeechTime > 0:
+		s.schedule(s.opts.SpeechTime, engine.EndSpeech{})
+	}
+}
+
+func (s *GameServer) lastWordsTime() time.Duration {
+	if s.state.NextPhase == engine.PhaseNight {
+		return s.opts.LastWords
+	}
+	return s.opts.NightLastWords
+}
+
+func (s *GameServer) speakerNames() string {
+	names := make([]string, 0)
+	for _, pid := range s.state.Speakers {
+		names = append(names, s.players[pid].Name)
+	}
+	return strings.Join(names, ", ")
+}
+
+func (s *GameServer) nomineeNames() string {
+	names := make([]string, 0)
+	for _, pid := range s.state.Nominees {
+		names = append(names, fmt.Sprintf("#%v %v", pid+1, s.players[pid].Name))
+	}
+	return strings.Join(names, ", ")
+}
+
+func seatNumbers(pids []int) string {
+	seats := make([]string, 0)
+	for _, pid := range pids {
+		seats = append(seats, fmt.Sprintf("#%v", pid+1))
+	}
+	return strings.Join(seats, ", ")
+}
+
+func (s *GameServer) broadcastForceEnd() {
+	if s.shutdown {
+		s.broadcastMsgFromServer("chat.stopped_shutdown")
+	} else {
+		s.broadcastMsgFromServer("chat.stopped_admin")
+	}
+}
+
+func (s *GameServer) forceEnd() error {
+	s.logger().Info("Force end game", "shutdown", s.shutdown)
+	return s.apply(engine.ForceEnd{})
+}
+
+// Warns players that server is going down, game may be finished within drain timeout
+func (s *GameServer) NotifyShutdown(drain time.Duration) {
+	s.do(func() error {
+		s.logger().Info("Notify game about shutdown", "drain", drain)
+		s.broadcastEvent(shutdownEvent(drain))
+		return nil
+	})
+}
+
+// Stops game because of server shutdown, does nothing if game is already over.
+// With data dir game is saved paused and continues after restart, otherwise it is force ended.
+func (s *GameServer) Shutdown() {
+	s.do(func() error {
+		s.shutdown = true
+		if s.opts.DataDir == "" {
+			return s.forceEnd()
+		}
+		s.logger().Info("Save game for restart")
+		s.stopTimer()
+		s.paused = true
+		s.checkpoint()
+		s.broadcastMsgFromServer("chat.saved_shutdown")
+		return nil
+	})
+}
+
+// Close releases players' connections, it must be called after Run has returned
 func (s *GameServer) Close() {
 	if s.closed {
 		return
 	}
 
-	log.Println("Closing game server...")
+	s.logger().Info("Closing game server")
 	s.closed = true
-	for _, p := range s.players {
-		close(p.GameEventChan)
+	// Game stopped by shutdown is resumed from its checkpoint after restart
+	if !s.shutdown || s.ended {
+		s.removeCheckpoint()
+	}
+	for pid, p := range s.players {
+		s.disconnect(p.Name)
+		p.Close()
+		s.events[pid].Close()
 	}
-	s.state.Close()
 }
 
 func (s *GameServer) SubscribeToGameEvent(req *proto.SubscribeToGameRequest, event_stream proto.Game_SubscribeToGameEventServer) error {
-	pind := s.getPid(req.Player)
-	if pind == -1 {
-		return errors.New("SubscribeToGameEvent: player not found!")
+	var pind int
+	err := s.do(func() error {
+		var err error
+		pind, err = s.validPlayer(req.Player)
+		return err
+	})
+	if err != nil {
+		return StatusError(err)
 	}
 
 	for {
-		e, ok := <-s.players[pind].GameEventChan
-		if !ok {
+		e, err := s.events[pind].Pop(event_stream.Context())
+		if err == io.EOF {
 			return nil
 		}
-		event_stream.Send(e)
+		if errors.Is(err, fanout.ErrOverflow) {
+			s.logger().Warn("Subscriber is too slow, disconnecting", logger.Player, req.Player.Name)
+			return errQueueFull
+		}
+		if err != nil {
+			return err
+		}
+		if err := event_stream.Send(e); err != nil {
+			return err
+		}
+	}
+}
+
+// Every log line about the game carries its identity, current phase and day number
+func (s *GameServer) logger() *slog.Logger {
+	phase, day := s.state.Phase, s.state.Day
+	if s.eventsAt != nil {
+		phase, day = s.eventsAt.phase, s.eventsAt.day
 	}
+	return s.log.With(logger.Phase, phase.String(), logger.Day, day)
+}
+
+type phaseAt struct {
+	phase engine.Phase
+	day   int
 }
 
 func (s *GameServer) getPid(pl *proto.Player) int {
@@ -135,193 +527,242 @@ func (s *GameServer) getPid(pl *proto.Player) int {
 }
 
 func (s *GameServer) broadcastEvent(e *proto.GameEvent) {
-	// Here we can write into closed chan, if server is closed, so we need to handle panic
-	defer func() {
-		if recover() != nil {
-			log.Print("Panic catched in broadcastEvent")
-		}
-	}()
-
-	log.Printf("Broadcast event: %v\n", e)
-	for _, p := range s.players {
-		p.GameEventChan <- e
+	s.logger().Info("Broadcast event", "type", e.Type.String(), "event", e.String())
+	for pid := range s.players {
+		s.sendEvent(pid, e)
 	}
 }
 
-// [TODO] make version with color
-func (s *GameServer) broadcastMsg(msg string) {
-	log.Printf("Broadcast message: %v\n", msg)
+func (s *GameServer) broadcastMsg(from string, m i18n.Message) {
+	if s.closed {
+		return
+	}
+	s.logger().Debug("Broadcast message", "key", m.Key, "args", m.Args)
 	for _, p := range s.players {
-		p.SendMsg(msg)
+		p.SendMsg(from, m)
 	}
 }
 
 func (s *GameServer) broadcastMsgFromPlayer(msg string, addr string, name string) {
-	s.broadcastMsg(fmt.Sprintf("%v##[%v] %v", addr, name, msg))
+	s.broadcastMsg(addr, i18n.Msg("chat.message", name, msg))
 }
 
-func (s *GameServer) broadcastMsgFromServer(msg string) {
-	s.broadcastMsg(fmt.Sprintf("server##[server] %v", msg))
-}
-
-func (s *GameServer) getPlayerNames() []string {
-	playerNames := make([]string, 0)
-	for _, pl := range s.players {
-		playerNames = append(playerNames, pl.Name)
+// sendMsgToTeam delivers player's message to alive members of the team only
+func (s *GameServer) sendMsgToTeam(team engine.Team, msg string, addr string, name string) {
+	m := i18n.Msg("chat.message", name, msg)
+	for pid, p := range s.state.Players {
+		if p.Alive && engine.GetRole(p.Role).Team() == team {
+			s.players[pid].SendMsg(addr, m)
+		}
 	}
-	return playerNames
 }
 
-func (s *GameServer) getPlayerRoles() []string {
-	playerRoles := make([]string, 0)
-	for _, pl := range s.players {
-		playerRoles = append(playerRoles, pl.Role)
-	}
-	return playerRoles
+// broadcastMsgFromServer sends message key, clients render it in their language
+func (s *GameServer) broadcastMsgFromServer(key string, args ...any) {
+	s.broadcastMsg(player.ServerSender, i18n.Msg(key, args...))
 }
 
 func (s *GameServer) MemberList(ctx context.Context, _ *proto.Empty) (*proto.MemberListResponse, error) {
-	s.mu.Lock()
-	defer s.mu.Unlock()
-
-	return &proto.MemberListResponse{PlayerNames: s.getPlayerNames()}, nil
+	// Players are never changed, so they can be read outside of the game goroutine
+	playerNames := make([]string, 0)
+	for _, pl := range s.players {
+		playerNames = append(playerNames, pl.Name)
+	}
+	return &proto.MemberListResponse{PlayerNames: playerNames}, nil
 }
 
 func (s *GameServer) SendMessage(_ context.Context, req *proto.SendMessageRequest) (*proto.Empty, error) {
-	s.mu.Lock()
-	defer s.mu.Unlock()
-
-	s.broadcastMsgFromPlayer(req.Msg, req.Player.Addr, req.Player.Name)
+	if req.Msg == "" {
+		return nil, errEmptyMsg
+	}
+	if len(req.Msg) > maxMessageLen {
+		return nil, errLongMsg
+	}
+	err := s.do(func() error {
+		pid, err := s.validPlayer(req.Player)
+		if err != nil {
+			return err
+		}
+		if s.state.Silenced(pid) {
+			// talking during somebody else's speech is a foul
+			if s.state.Speaker() != -1 && s.state.Players[pid].Alive {
+				s.foul(pid)
+				return engine.ErrNotYourTurn
+			}
+			return engine.ErrWrongPhase
+		}
+		metrics.ChatMessages.WithLabelValues("game").Inc()
+		if s.state.Phase == engine.PhaseMeeting {
+			s.sendMsgToTeam(engine.TeamMafia, req.Msg, s.players[pid].Addr, s.players[pid].Name)
+			return nil
+		}
+		s.broadcastMsgFromPlayer(req.Msg, s.players[pid].Addr, s.players[pid].Name)
+		return nil
+	})
+	if err != nil {
+		return nil, StatusError(err)
+	}
 	return &proto.Empty{}, nil
 }
 
 func (s *GameServer) Exit(_ context.Context, req *proto.ExitRequest) (*proto.Empty, error) {
-	s.mu.Lock()
-	defer s.mu.Unlock()
-
-	if !s.closed {
-		s.killPlayer(s.getPid(req.Player))
-		s.broadcastMsgFromPlayer(fmt.Sprintf("Игрок %v отключился!", req.Player.Name), req.Player.Addr, req.Player.Name)
+	err := s.do(func() error {
+		pid, err := s.validPlayer(req.Player)
+		if err != nil {
+			return err
+		}
+		p := s.players[pid]
+		s.disconnect(p.Name)
+		s.broadcastMsg(p.Addr, i18n.Msg("chat.left", p.Name))
+		s.leave(pid)
+		return nil
+	})
+	if err != nil {
+		return nil, StatusError(err)
 	}
-
 	return &proto.Empty{}, nil
 }
 
-func (s *GameServer) killPlayer(pid int) bool {
-	log.Printf("Убиваем игрока %v...\n", s.players[pid].Name)
-	if pid == -1 {
-		log.Printf("Trying to kill unknown player: %v", pid)
-		return false
-	}
-	if !s.players[pid].Alive {
-		log.Println("Убиваем мертвого!")
-		return false
-	}
-	s.players[pid].Alive = false
-	s.state.TryFinishVote()
-	s.players[pid].GameEventChan <- &proto.GameEvent{Type: "dead", Event: &proto.GameEvent_Dead{}}
-	if s.players[pid].Role == "com" {
-		s.state.CheckChanClosed = true
-		close(s.state.CheckChan)
+// Player has left the game and is not counted as connected anymore
+func (s *GameServer) disconnect(name string) {
+	if s.getPid(&proto.Player{Name: name}) == -1 || s.exited[name] {
+		return
 	}
-	return true
+	s.exited[name] = true
+	metrics.ConnectedPlayers.Dec()
 }
 
-func (s *GameServer) Role(_ context.Context, req *proto.RoleRequest) (*proto.RoleResponse, error) {
-	s.mu.Lock()
-	defer s.mu.Unlock()
-
-	pid := s.getPid(req.Player)
-	if pid == -1 {
-		return nil, errors.New("Игрок не найден!")
+// leave kills player who has left the game
+func (s *GameServer) leave(pid int) {
+	if err := s.apply(engine.Leave{Player: pid}); err != nil && !errors.Is(err, engine.ErrGameOver) {
+		s.logger().Warn("Can't remove player from game", logger.Error, err)
 	}
-	return &proto.RoleResponse{Role: s.players[pid].Role}, nil
 }
 
-func (s *GameServer) Vote(_ context.Context, req *proto.VoteRequest) (*proto.Empty, error) {
-	s.mu.Lock()
-	defer s.mu.Unlock()
-
-	pid := s.getPid(req.Player)
-	if !s.players[pid].Alive {
-		return nil, errors.New("Vote: голос от мертвеца")
+// foul gives player a foul, it does nothing if fouls are disabled
+func (s *GameServer) foul(pid int) {
+	err := s.apply(engine.Foul{Player: pid})
+	if err != nil && !errors.Is(err, engine.ErrUnknownAction) && !errors.Is(err, engine.ErrGameOver) {
+		s.logger().Warn("Can't give foul", logger.Error, err)
 	}
+}
 
-	vid := int(req.Voting)
-	err := s.state.Vote(pid, vid)
-	if err == nil {
-		if vid != -1 {
-			s.broadcastMsgFromServer(fmt.Sprintf("Игрок #%v проголосовал против игрока #%v!", pid+1, vid+1))
-		} else {
-			s.broadcastMsgFromServer(fmt.Sprintf("Игрок #%v решил не голосовать!", pid+1))
+func (s *GameServer) Role(_ context.Context, req *proto.RoleRequest) (*proto.RoleResponse, error) {
+	resp := &proto.RoleResponse{}
+	err := s.do(func() error {
+		pid, err := s.validPlayer(req.Player)
+		if err != nil {
+			return err
 		}
+		resp.Role = s.state.Players[pid].Role
+		resp.Info = roleInfo(engine.GetRole(resp.Role))
+		return nil
+	})
+	if err != nil {
+		return nil, StatusError(err)
 	}
-
-	return &proto.Empty{}, err
+	return resp, nil
 }
 
-func (s *GameServer) Kill(_ context.Context, req *proto.KillRequest) (*proto.Empty, error) {
-	s.mu.Lock()
-	defer s.mu.Unlock()
+// Roles are registered at init, so they can be listed outside of the game goroutine
+func (s *GameServer) RoleList(_ context.Context, _ *proto.Empty) (*proto.RoleListResponse, error) {
+	resp := &proto.RoleListResponse{}
+	for _, r := range engine.AllRoles() {
+		resp.Roles = append(resp.Roles, roleInfo(r))
+	}
+	return resp, nil
+}
 
-	pid := s.getPid(req.Player)
-	kid := int(req.Killing)
-	if !s.players[pid].Alive {
-		return nil, errors.New("Kill: голос от мертвеца")
+func roleInfo(r engine.Role) *proto.RoleInfo {
+	info := &proto.RoleInfo{
+		Id:        r.ID(),
+		Title:     r.Title(),
+		Team:      r.Team().ID,
+		TeamTitle: r.Team().Title,
+		Color:     r.Color(),
+		NightHint: r.NightHint(),
 	}
-	if s.players[pid].Role != "maf" {
-		return nil, errors.New("Kill: голос от мирного")
+	for _, rule := range r.Night() {
+		info.NightActions = append(info.NightActions, rule.Kind)
 	}
-	if !s.players[kid].Alive {
-		return nil, errors.New("Kill: игрок уже мёртв!")
+	if len(info.NightActions) > 0 {
+		info.NightAction = info.NightActions[0]
 	}
+	return info
+}
 
-	err := s.state.Kill(pid, kid)
+func (s *GameServer) Vote(_ context.Context, req *proto.VoteRequest) (*proto.Empty, error) {
+	err := s.do(func() error {
+		pid, err := s.validPlayer(req.Player)
+		if err != nil {
+			return err
+		}
+		return s.perform(pid, engine.ActionVote, []int32{req.Voting})
+	})
 	if err != nil {
-		return nil, err
-	}
-	if !s.killPlayer(kid) {
-		return nil, errors.New("Kill: weird shit")
+		return nil, StatusError(err)
 	}
 	return &proto.Empty{}, nil
 }
 
-func (s *GameServer) Check(_ context.Context, req *proto.CheckRequest) (*proto.CheckResponse, error) {
-	s.mu.Lock()
-	defer s.mu.Unlock()
-
-	log.Println("Check...")
-
-	pid := s.getPid(req.Player)
-	cid := int(req.Checking)
-	if !s.players[pid].Alive {
-		return nil, errors.New("Check: проверка от мертвеца")
-	}
-	if s.players[pid].Role != "com" {
-		return nil, errors.New("Check: проверка не от копа")
+func (s *GameServer) Kill(_ context.Context, req *proto.KillRequest) (*proto.Empty, error) {
+	err := s.do(func() error {
+		pid, err := s.validPlayer(req.Player)
+		if err != nil {
+			return err
+		}
+		return s.perform(pid, engine.ActionKill, []int32{req.Killing})
+	})
+	if err != nil {
+		return nil, StatusError(err)
 	}
+	return &proto.Empty{}, nil
+}
 
-	err := s.state.Check(pid, cid)
+// Check result is sent to the checker at dawn
+func (s *GameServer) Check(_ context.Context, req *proto.CheckRequest) (*proto.CheckResponse, error) {
+	err := s.do(func() error {
+		pid, err := s.validPlayer(req.Player)
+		if err != nil {
+			return err
+		}
+		return s.perform(pid, engine.ActionCheck, []int32{req.Checking})
+	})
 	if err != nil {
-		return nil, err
+		return nil, StatusError(err)
 	}
-
-	log.Println("Check end...")
-
-	return &proto.CheckResponse{Role: s.players[cid].Role}, nil
+	return &proto.CheckResponse{}, nil
 }
 
 func (s *GameServer) AliveList(_ context.Context, _ *proto.Empty) (*proto.AliveListResponse, error) {
-	s.mu.Lock()
-	defer s.mu.Unlock()
-
-	playerNames := make([]string, 0)
-	pids := make([]int32, 0)
-	for i, pl := range s.players {
-		if pl.Alive {
-			playerNames = append(playerNames, pl.Name)
-			pids = append(pids, int32(i))
+	resp := &proto.AliveListResponse{PlayerNames: make([]string, 0), Pids: make([]int32, 0)}
+	err := s.do(func() error {
+		for i, pl := range s.state.Players {
+			if pl.Alive {
+				resp.PlayerNames = append(resp.PlayerNames, pl.Name)
+				resp.Pids = append(resp.Pids, int32(i))
+			}
 		}
+		return nil
+	})
+	if err != nil {
+		return nil, StatusError(err)
 	}
-	return &proto.AliveListResponse{PlayerNames: playerNames, Pids: pids}, nil
+	return resp, nil
+}
+
+func protoPhase(phase engine.Phase) proto.Phase {
+	switch phase {
+	case engine.PhaseDay:
+		return proto.Phase_PHASE_DAY
+	case engine.PhaseNight:
+		return proto.Phase_PHASE_NIGHT
+	case engine.PhaseLastWords:
+		return proto.Phase_PHASE_LAST_WORDS
+	case engine.PhaseVoting:
+		return proto.Phase_PHASE_VOTING
+	case engine.PhaseMeeting:
+		return proto.Phase_PHASE_MEETING
+	}
+	return proto.Phase_PHASE_UNSPECIFIED
 }
@@ -0,0 +1,82 @@
+package server
+
+import (
+	"context"
+
+	"github.com/GandarfHSE/go-mafia/internal/engine"
+	"github.com/GandarfHSE/go-mafia/internal/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// GetState returns game as it is seen by the caller, so client can resync at any time
+func (s *GameServer) GetState(_ context.Context, req *proto.StateRequest) (*proto.GameStateResponse, error) {
+	var resp *proto.GameStateResponse
+	err := s.do(func() error {
+		pid, err := s.validPlayer(req.Player)
+		if err != nil {
+			return err
+		}
+		resp = s.snapshot(pid)
+		return nil
+	})
+	if err != nil {
+		return nil, StatusError(err)
+	}
+	return resp, nil
+}
+
+func (s *GameServer) snapshot(pid int) *proto.GameStateResponse {
+	me := s.state.Players[pid]
+
+	resp := &proto.GameStateResponse{
+		Phase:  protoPhase(s.state.Phase),
+		Day:    int32(s.state.Day),
+		Paused: s.paused,
+		Ended:  s.ended,
+		Pid:    int32(pid),
+		Role:   me.Role,
+		Alive:  me.Alive,
+	}
+
+	for i, p := range s.state.Players {
+		resp.Seats = append(resp.Seats, &proto.Seat{
+			Pid:       int32(i),
+			Name:      p.Name,
+			Alive:     p.Alive,
+			Connected: !s.exited[p.Name],
+			Role:      s.state.KnownRole(pid, i),
+			Fouls:     int32(s.state.Fouls[i]),
+		})
+	}
+
+	for _, c := range s.state.Investigations(pid) {
+		resp.Checks = append(resp.Checks, &proto.CheckResult{
+			Day:    int32(c.Day),
+			Pid:    int32(c.Checked),
+			Role:   s.state.CheckedRole(c),
+			Action: c.Action(),
+		})
+	}
+
+	if !s.deadline.IsZero() {
+		resp.Deadline = timestamppb.New(s.deadline)
+	}
+	for _, pid := range s.state.Speakers {
+		resp.Speakers = append(resp.Speakers, int32(pid))
+	}
+
+	if s.state.Phase == engine.PhaseDay || s.state.Phase == engine.PhaseVoting {
+		for i, v := range s.state.Votes {
+			resp.Votes = append(resp.Votes, int32(v))
+			resp.VotesCount = append(resp.VotesCount, int32(s.state.VotesCount[i]))
+		}
+		for _, pid := range s.state.Nominees {
+			resp.Nominees = append(resp.Nominees, int32(pid))
+		}
+	}
+	resp.Voter = int32(s.state.Voter())
+	resp.Speaker = int32(s.state.Speaker())
+
+	resp.LastSeq = s.eventSeqs[pid]
+	return resp
+}
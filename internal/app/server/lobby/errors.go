@@ -0,0 +1,37 @@
+package server
+
+import (
+	"github.com/GandarfHSE/go-mafia/internal/proto"
+	"github.com/GandarfHSE/go-mafia/internal/utils/grpcutil"
+	"google.golang.org/grpc/codes"
+)
+
+const maxMessageLen int = 1000
+
+var (
+	errNoPlayer     = grpcutil.Error(codes.InvalidArgument, proto.ErrorReason_ERROR_REASON_BAD_REQUEST, "player with name and address is required")
+	errBadAddr      = grpcutil.Error(codes.InvalidArgument, proto.ErrorReason_ERROR_REASON_BAD_REQUEST, "chat address is unreachable")
+	errEmptyMsg     = grpcutil.Error(codes.InvalidArgument, proto.ErrorReason_ERROR_REASON_BAD_REQUEST, "msg is empty")
+	errLongMsg      = grpcutil.Error(codes.InvalidArgument, proto.ErrorReason_ERROR_REASON_BAD_REQUEST, "msg is too long")
+	errBanned       = grpcutil.Error(codes.PermissionDenied, proto.ErrorReason_ERROR_REASON_BANNED, "player name is banned")
+	errNameTaken    = grpcutil.Error(codes.AlreadyExists, proto.ErrorReason_ERROR_REASON_NAME_TAKEN, "player name is already taken")
+	errNotInLobby   = grpcutil.Error(codes.NotFound, proto.ErrorReason_ERROR_REASON_UNKNOWN_PLAYER, "player is not in lobby")
+	errShuttingDown = grpcutil.Error(codes.Unavailable, proto.ErrorReason_ERROR_REASON_SHUTTING_DOWN, "server is shutting down")
+)
+
+func validPlayer(pl *proto.Player) error {
+	if pl == nil || pl.Name == "" || pl.Addr == "" {
+		return errNoPlayer
+	}
+	return nil
+}
+
+func validMessage(msg string) error {
+	if msg == "" {
+		return errEmptyMsg
+	}
+	if len(msg) > maxMessageLen {
+		return errLongMsg
+	}
+	return nil
+}
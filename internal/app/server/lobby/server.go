@@ -2,24 +2,34 @@ package server
 
 import (
 	"context"
-	"errors"
 	"fmt"
-	"log"
+	"log/slog"
 	"math/rand"
 	"net"
+	"path/filepath"
+	"sort"
 	"sync"
 	"time"
 
+	"github.com/GandarfHSE/go-mafia/internal/app/server/config"
 	game "github.com/GandarfHSE/go-mafia/internal/app/server/game"
+	"github.com/GandarfHSE/go-mafia/internal/app/server/metrics"
 	"github.com/GandarfHSE/go-mafia/internal/proto"
 	"github.com/GandarfHSE/go-mafia/internal/utils/algo"
+	"github.com/GandarfHSE/go-mafia/internal/utils/fanout"
+	"github.com/GandarfHSE/go-mafia/internal/utils/grpcutil"
+	"github.com/GandarfHSE/go-mafia/internal/utils/i18n"
+	"github.com/GandarfHSE/go-mafia/internal/utils/logger"
 	"github.com/GandarfHSE/go-mafia/internal/utils/player"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
 )
 
 const (
 	GamePlayers int = 4
+
+	gameStopTimeout time.Duration = 5 * time.Second
 )
 
 type LobbyServer struct {
@@ -28,70 +38,139 @@ type LobbyServer struct {
 	players []player.Player
 	mu      sync.Mutex
 
-	gameWG   sync.WaitGroup
-	gameAddr string
+	// filling is the game lobby players will get, started keeps games of players who haven't subscribed yet
+	filling *pendingGame
+	started map[string]*pendingGame
+
+	games    map[string]*lobbyGame
+	banned   map[string]bool
+	bansMu   sync.Mutex // serializes writes of bans file
+	grpcOpts []grpc.ServerOption
+	dataDir  string
+	gameOpts game.Options
+
+	shuttingDown bool
+	shutdownChan chan struct{}
+}
+
+// pendingGame is ready when lobby is full, then its address is handed out to its players
+type pendingGame struct {
+	ready chan struct{}
+	addr  string
+	id    string
+}
+
+func createPendingGame() *pendingGame {
+	return &pendingGame{ready: make(chan struct{})}
 }
 
-func CreateLobbyServer() *LobbyServer {
+type lobbyGame struct {
+	server     *game.GameServer
+	grpcServer *grpc.Server
+	done       chan struct{}
+}
+
+// grpcOpts are used for game servers started by lobby
+func CreateLobbyServer(cfg *config.Config, grpcOpts ...grpc.ServerOption) *LobbyServer {
 	lobby := &LobbyServer{
-		players:  nil,
-		gameAddr: "",
+		grpcOpts:     grpcOpts,
+		dataDir:      cfg.DataDir,
+		gameOpts:     gameOptions(cfg),
+		players:      nil,
+		filling:      createPendingGame(),
+		started:      make(map[string]*pendingGame),
+		games:        make(map[string]*lobbyGame),
+		banned:       make(map[string]bool),
+		shutdownChan: make(chan struct{}),
 	}
-	lobby.gameWG.Add(GamePlayers)
+	lobby.loadBans()
+	lobby.restoreGames()
+	metrics.LobbyCapacity.Set(float64(GamePlayers))
 	return lobby
 }
 
-func (s *LobbyServer) Close() {
-	// [TODO] Make destructor
+func gameOptions(cfg *config.Config) game.Options {
+	spillDir := ""
+	if cfg.DataDir != "" {
+		spillDir = filepath.Join(cfg.DataDir, "spill")
+	}
+	return game.Options{
+		DataDir: cfg.DataDir,
+		Events: fanout.Options{
+			Size:     cfg.EventQueueSize,
+			Policy:   cfg.EventQueuePolicy,
+			SpillDir: spillDir,
+			OnDrop:   metrics.DroppedMessages.WithLabelValues("events").Inc,
+		},
+		// Chat is not worth reconnecting or disk space, old messages are just dropped
+		Chat: fanout.Options{
+			Size:   cfg.ChatQueueSize,
+			Policy: fanout.DropOldest,
+			OnDrop: metrics.DroppedMessages.WithLabelValues("chat").Inc,
+		},
+		LastWords:      cfg.LastWords,
+		NightLastWords: cfg.NightLastWords,
+
+		Nominations:     cfg.Nominations,
+		SeatOrderVoting: cfg.SeatOrderVoting,
+
+		Sport:       cfg.Ruleset == config.RulesetSport,
+		SpeechTime:  cfg.SpeechTime,
+		MeetingTime: cfg.MeetingTime,
+	}
 }
 
 func (s *LobbyServer) addPlayer(pbplayer *proto.Player) error {
+	if s.banned[pbplayer.Name] {
+		return errBanned
+	}
 	for _, p := range s.players {
 		if p.Name == pbplayer.Name {
-			return errors.New("Игрок с таким именем уже существует!")
+			return errNameTaken
 		}
 	}
 
 	conn, err := net.Dial("udp", pbplayer.Addr)
 	if err != nil {
-		log.Printf("Can't add player %v with addr %v!\n", pbplayer.Name, pbplayer.Addr)
-		return err
+		slog.Warn("Can't add player", logger.Player, pbplayer.Name, "addr", pbplayer.Addr, logger.Error, err)
+		return errBadAddr
 	}
 
-	newPlayer := player.Player{
-		Name:          pbplayer.Name,
-		Addr:          pbplayer.Addr,
-		Conn:          conn,
-		Role:          "anon",
-		Alive:         true,
-		GameEventChan: make(chan *proto.GameEvent),
-	}
-	s.players = append(s.players, newPlayer)
+	s.players = append(s.players, player.CreatePlayer(pbplayer.Name, pbplayer.Addr, conn, s.gameOpts.Chat))
+	metrics.ConnectedPlayers.Inc()
+	metrics.LobbyPlayers.Set(float64(len(s.players)))
 
 	return nil
 }
 
-// [TODO] make version with color
-func (s *LobbyServer) broadcastMsg(msg string) {
-	log.Printf("Broadcast message: %v\n", msg)
+func (s *LobbyServer) broadcastMsg(from string, m i18n.Message) {
+	slog.Debug("Broadcast lobby message", "key", m.Key, "args", m.Args)
 	for _, p := range s.players {
-		p.SendMsg(msg)
+		p.SendMsg(from, m)
 	}
 }
 
 func (s *LobbyServer) broadcastMsgFromPlayer(msg string, addr string, name string) {
-	s.broadcastMsg(fmt.Sprintf("%v##[%v] %v", addr, name, msg))
+	s.broadcastMsg(addr, i18n.Msg("chat.message", name, msg))
 }
 
-func (s *LobbyServer) broadcastMsgFromServer(msg string) {
-	s.broadcastMsg(fmt.Sprintf("server##[server] %v", msg))
+// broadcastMsgFromServer sends message key, clients render it in their language
+func (s *LobbyServer) broadcastMsgFromServer(key string, args ...any) {
+	s.broadcastMsg(player.ServerSender, i18n.Msg(key, args...))
 }
 
 func (s *LobbyServer) Join(ctx context.Context, req *proto.JoinRequest) (*proto.Empty, error) {
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
-	log.Printf("Join request from %v with addr %v\n", req.Player.Name, req.Player.Addr)
+	if s.shuttingDown {
+		return nil, errShuttingDown
+	}
+	if err := validPlayer(req.Player); err != nil {
+		return nil, err
+	}
+
+	slog.Info("Join request", logger.Player, req.Player.Name, "addr", req.Player.Addr)
 
 	p, _ := peer.FromContext(ctx)
 	port := 0
@@ -110,14 +189,12 @@ func (s *LobbyServer) Join(ctx context.Context, req *proto.JoinRequest) (*proto.
 		return nil, err
 	}
 
-	msg := fmt.Sprintf("Игрок %v успешно присоединился к лобби!", req.Player.Name)
-	s.broadcastMsgFromPlayer(msg, req.Player.Addr, req.Player.Name)
+	s.broadcastMsg(req.Player.Addr, i18n.Msg("chat.joined", req.Player.Name))
 
 	if len(s.players) == GamePlayers {
 		// start game
 		s.PrepareGame()
 	}
-	s.gameWG.Done()
 
 	return &proto.Empty{}, nil
 }
@@ -134,76 +211,260 @@ func (s *LobbyServer) MemberList(ctx context.Context, _ *proto.Empty) (*proto.Me
 }
 
 func (s *LobbyServer) SendMessage(_ context.Context, req *proto.SendMessageRequest) (*proto.Empty, error) {
+	if err := validPlayer(req.Player); err != nil {
+		return nil, err
+	}
+	if err := validMessage(req.Msg); err != nil {
+		return nil, err
+	}
+
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
+	if s.findPlayer(req.Player) == -1 {
+		return nil, errNotInLobby
+	}
+
+	metrics.ChatMessages.WithLabelValues("lobby").Inc()
 	s.broadcastMsgFromPlayer(req.Msg, req.Player.Addr, req.Player.Name)
 	return &proto.Empty{}, nil
 }
 
 func (s *LobbyServer) Exit(_ context.Context, req *proto.ExitRequest) (*proto.Empty, error) {
+	if err := validPlayer(req.Player); err != nil {
+		return nil, err
+	}
+
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
-	if len(s.players) <= 0 {
-		return nil, errors.New("Weird shit")
+	pind := s.findPlayer(req.Player)
+	if pind == -1 {
+		return nil, errNotInLobby
 	}
 
-	pind := -1
+	s.removePlayer(pind)
+	s.broadcastMsg(req.Player.Addr, i18n.Msg("chat.left", req.Player.Name))
+
+	return &proto.Empty{}, nil
+}
+
+// findPlayer returns -1 if player is not in lobby.
+// Names are unique, while address is rewritten by Join and differs from the one client knows.
+func (s *LobbyServer) findPlayer(pl *proto.Player) int {
 	for i, p := range s.players {
-		if p.Addr == req.Player.Addr && p.Name == req.Player.Name {
-			pind = i
-			break
+		if p.Name == pl.Name {
+			return i
 		}
 	}
+	return -1
+}
 
-	if pind == -1 {
-		return nil, errors.New("Player is not found!")
-	}
-
+func (s *LobbyServer) removePlayer(pind int) {
+	s.players[pind].Close()
 	s.players = algo.Erase(s.players, pind)
-	s.gameWG.Add(1)
-	s.broadcastMsgFromPlayer(fmt.Sprintf("Игрок %v отключился!", req.Player.Name), req.Player.Addr, req.Player.Name)
-
-	return &proto.Empty{}, nil
+	metrics.ConnectedPlayers.Dec()
+	metrics.LobbyPlayers.Set(float64(len(s.players)))
 }
 
-func (s *LobbyServer) SubscribeToGame(_ context.Context, _ *proto.Empty) (*proto.SubscribeToGameResponse, error) {
-	s.gameWG.Wait()
+func (s *LobbyServer) SubscribeToGame(ctx context.Context, req *proto.SubscribeToGameRequest) (*proto.SubscribeToGameResponse, error) {
+	if err := validPlayer(req.Player); err != nil {
+		return nil, err
+	}
+	name := req.Player.Name
+
+	s.mu.Lock()
+	g, ok := s.started[name]
+	if !ok {
+		if s.findPlayer(req.Player) == -1 {
+			s.mu.Unlock()
+			return nil, errNotInLobby
+		}
+		g = s.filling
+	}
+	s.mu.Unlock()
+
+	select {
+	case <-g.ready:
+	case <-ctx.Done():
+		return nil, status.FromContextError(ctx.Err()).Err()
+	case <-s.shutdownChan:
+		return nil, errShuttingDown
+	}
 	time.Sleep(time.Second)
-	defer s.gameWG.Add(1)
-	return &proto.SubscribeToGameResponse{GameAddr: s.gameAddr}, nil
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	// Player may leave lobby before it is full
+	if s.started[name] != g {
+		return nil, errNotInLobby
+	}
+	delete(s.started, name)
+	return &proto.SubscribeToGameResponse{GameAddr: g.addr, GameId: g.id}, nil
 }
 
 func (s *LobbyServer) PrepareGame() {
-	log.Println("Preparing game...")
+	slog.Debug("Preparing game")
 
 	rnd := rand.New(rand.NewSource(time.Now().Unix()))
 
 	var lis net.Listener
 	var err error
+	var addr string
 
 	for i := 0; i < 5; i++ {
 		// [TODO] Get this from config
 		port := 9000 + rnd.Uint32()%100
-		s.gameAddr = fmt.Sprintf(":%v", port)
-		lis, err = net.Listen("tcp", s.gameAddr)
+		addr = fmt.Sprintf(":%v", port)
+		lis, err = net.Listen("tcp", addr)
 		if err == nil {
 			break
 		}
 	}
-	log.Printf("Start game server at %v\n", s.gameAddr)
 
-	gameServer := game.CreateGameServer(s.players)
-	grpcServer := grpc.NewServer()
+	id := fmt.Sprintf("%08x", rnd.Uint32())
+	s.startGame(game.CreateGameServer(id, addr, s.players, s.gameOpts), lis)
+
+	s.filling.addr = addr
+	s.filling.id = id
+	for _, p := range s.players {
+		s.started[p.Name] = s.filling
+	}
+	close(s.filling.ready)
+	s.filling = createPendingGame()
+	s.broadcastMsgFromServer("chat.lobby_full")
+	s.players = nil
+	metrics.LobbyPlayers.Set(0)
+}
+
+// restoreGames resumes games which were running when server died.
+// They listen on the same addresses, so players can reconnect.
+func (s *LobbyServer) restoreGames() {
+	for _, gameServer := range game.LoadGameServers(s.gameOpts) {
+		lis, err := net.Listen("tcp", gameServer.Addr())
+		if err != nil {
+			slog.Error("Can't listen for restored game", logger.GameID, gameServer.ID(), logger.Error, err)
+			gameServer.Close()
+			continue
+		}
+		s.startGame(gameServer, lis)
+	}
+}
+
+func (s *LobbyServer) startGame(gameServer *game.GameServer, lis net.Listener) {
+	id := gameServer.ID()
+	slog.Info("Start game server", logger.GameID, id, "addr", gameServer.Addr())
+	grpcServer := grpc.NewServer(append(s.grpcOpts, logger.ServerOptions(logger.GameID, id)...)...)
 	proto.RegisterGameServer(grpcServer, gameServer)
+	g := &lobbyGame{server: gameServer, grpcServer: grpcServer, done: make(chan struct{})}
+	s.games[id] = g
+	metrics.ActiveGames.Inc()
 	go func() {
 		gameServer.Run()
 		gameServer.Close()
+		metrics.ActiveGames.Dec()
+		grpcutil.GracefulStop(grpcServer, gameStopTimeout)
+
+		s.mu.Lock()
+		delete(s.games, id)
+		for name, pg := range s.started {
+			if pg.id == id {
+				delete(s.started, name)
+			}
+		}
+		s.mu.Unlock()
+		close(g.done)
 	}()
 	go func() {
 		grpcServer.Serve(lis)
 	}()
-	s.broadcastMsgFromServer("Лобби заполнено...")
-	s.players = nil
+}
+
+// Operator-facing methods, used by admin service
+
+func (s *LobbyServer) LobbyInfo() *proto.LobbyInfo {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	playerNames := make([]string, 0)
+	for _, pl := range s.players {
+		playerNames = append(playerNames, pl.Name)
+	}
+	return &proto.LobbyInfo{PlayerNames: playerNames, Capacity: int32(GamePlayers)}
+}
+
+func (s *LobbyServer) Games() []*game.GameServer {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	games := make([]*game.GameServer, 0)
+	for _, g := range s.games {
+		games = append(games, g.server)
+	}
+	sort.Slice(games, func(i, j int) bool {
+		return games[i].ID() < games[j].ID()
+	})
+	return games
+}
+
+func (s *LobbyServer) Game(id string) (*game.GameServer, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	g, ok := s.games[id]
+	if !ok {
+		return nil, false
+	}
+	return g.server, true
+}
+
+func (s *LobbyServer) Kick(name string, ban bool) bool {
+	if ban {
+		slog.Info("Ban player", logger.Player, name)
+		s.mu.Lock()
+		s.banned[name] = true
+		s.mu.Unlock()
+		// Saved right away, so crash doesn't lose the ban
+		s.saveBans()
+	}
+
+	s.mu.Lock()
+	for i, p := range s.players {
+		if p.Name == name {
+			p.SendMsg(player.ServerSender, i18n.Msg("chat.kicked_you_lobby"))
+			s.removePlayer(i)
+			s.broadcastMsgFromServer("chat.kicked_lobby", name)
+			s.mu.Unlock()
+			return true
+		}
+	}
+	s.mu.Unlock()
+
+	// Games process commands in their own goroutine, lobby mutex isn't held while waiting for them
+	for _, g := range s.Games() {
+		if g.Kick(name) {
+			return true
+		}
+	}
+	return false
+}
+
+// Foul gives a foul to the player in whichever game they are playing
+func (s *LobbyServer) Foul(name string) (bool, error) {
+	for _, g := range s.Games() {
+		if found, err := g.Foul(name); found {
+			return true, err
+		}
+	}
+	return false, nil
+}
+
+func (s *LobbyServer) Announce(msg string) {
+	s.mu.Lock()
+	s.broadcastMsgFromServer("chat.announce", msg)
+	s.mu.Unlock()
+
+	for _, g := range s.Games() {
+		g.Announce(msg)
+	}
 }
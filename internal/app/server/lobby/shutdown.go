@@ -0,0 +1,137 @@
+package server
+
+import (
+	"encoding/json"
+	"errors"
+	"io/fs"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"github.com/GandarfHSE/go-mafia/internal/utils/logger"
+)
+
+const (
+	bansFile string = "bans.json"
+)
+
+// Shutdown stops accepting joins, notifies all players and stops running games.
+// Games may finish on their own within drain timeout, after it they are stopped:
+// saved paused to continue after restart if there is data dir, force ended otherwise.
+func (s *LobbyServer) Shutdown(drain time.Duration) {
+	s.mu.Lock()
+	if s.shuttingDown {
+		s.mu.Unlock()
+		return
+	}
+
+	slog.Info("Shutting down lobby", "drain", drain)
+	s.shuttingDown = true
+	close(s.shutdownChan)
+	s.broadcastMsgFromServer("chat.lobby_shutdown")
+
+	games := make([]*lobbyGame, 0)
+	for _, g := range s.games {
+		games = append(games, g)
+	}
+	s.mu.Unlock()
+
+	// Event delivery may block on slow subscribers, so it must not block shutdown
+	for _, g := range games {
+		go g.server.NotifyShutdown(drain)
+	}
+
+	if drain > 0 {
+		deadline := time.After(drain)
+	drainLoop:
+		for _, g := range games {
+			select {
+			case <-g.done:
+			case <-deadline:
+				break drainLoop
+			}
+		}
+	}
+
+	for _, g := range games {
+		g.server.Shutdown()
+	}
+	for _, g := range games {
+		select {
+		case <-g.done:
+		case <-time.After(gameStopTimeout):
+			slog.Warn("Game is not stopped in time", logger.GameID, g.server.ID())
+			g.grpcServer.Stop()
+		}
+	}
+
+	s.saveBans()
+}
+
+// Close releases lobby players' connections, call it after Shutdown
+func (s *LobbyServer) Close() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, p := range s.players {
+		p.Close()
+	}
+	s.players = nil
+}
+
+func (s *LobbyServer) loadBans() {
+	if s.dataDir == "" {
+		return
+	}
+
+	data, err := os.ReadFile(filepath.Join(s.dataDir, bansFile))
+	if errors.Is(err, fs.ErrNotExist) {
+		return
+	}
+	if err != nil {
+		slog.Error("Can't read bans", logger.Error, err)
+		return
+	}
+
+	names := make([]string, 0)
+	if err := json.Unmarshal(data, &names); err != nil {
+		slog.Error("Can't parse bans", logger.Error, err)
+		return
+	}
+	for _, name := range names {
+		s.banned[name] = true
+	}
+	slog.Info("Bans loaded", "count", len(names))
+}
+
+func (s *LobbyServer) saveBans() {
+	if s.dataDir == "" {
+		return
+	}
+
+	s.bansMu.Lock()
+	defer s.bansMu.Unlock()
+
+	s.mu.Lock()
+	names := make([]string, 0)
+	for name := range s.banned {
+		names = append(names, name)
+	}
+	s.mu.Unlock()
+	sort.Strings(names)
+
+	data, err := json.Marshal(names)
+	if err != nil {
+		slog.Error("Can't marshal bans", logger.Error, err)
+		return
+	}
+	if err := os.MkdirAll(s.dataDir, 0o755); err != nil {
+		slog.Error("Can't create data dir", logger.Error, err)
+		return
+	}
+	if err := os.WriteFile(filepath.Join(s.dataDir, bansFile), data, 0o644); err != nil {
+		slog.Error("Can't save bans", logger.Error, err)
+	}
+}
@@ -0,0 +1,89 @@
+package metrics
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+)
+
+var (
+	ConnectedPlayers = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "mafia_connected_players",
+		Help: "Number of players connected to lobby or to active games.",
+	})
+	LobbyPlayers = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "mafia_lobby_players",
+		Help: "Number of players waiting in lobby.",
+	})
+	LobbyCapacity = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "mafia_lobby_capacity",
+		Help: "Number of players required to start a game.",
+	})
+	ActiveGames = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "mafia_active_games",
+		Help: "Number of running games.",
+	})
+	GamesFinished = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "mafia_games_finished_total",
+		Help: "Number of finished games by winning side.",
+	}, []string{"winner"})
+	PhaseDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "mafia_phase_duration_seconds",
+		Help:    "Duration of game phases.",
+		Buckets: []float64{5, 15, 30, 60, 120, 300, 600, 1200},
+	}, []string{"phase"})
+	ChatMessages = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "mafia_chat_messages_total",
+		Help: "Number of chat messages sent by players.",
+	}, []string{"where"})
+	DroppedMessages = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "mafia_dropped_messages_total",
+		Help: "Number of events and chat messages dropped because subscriber's queue was full.",
+	}, []string{"queue"})
+
+	rpcRequests = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "mafia_rpc_requests_total",
+		Help: "Number of handled RPCs by method and status code.",
+	}, []string{"method", "code"})
+	rpcDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "mafia_rpc_duration_seconds",
+		Help:    "RPC handling latency by method.",
+		Buckets: prometheus.DefBuckets,
+	}, []string{"method"})
+)
+
+func observeRPC(method string, start time.Time, err error) {
+	rpcRequests.WithLabelValues(method, status.Code(err).String()).Inc()
+	rpcDuration.WithLabelValues(method).Observe(time.Since(start).Seconds())
+}
+
+func UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		start := time.Now()
+		resp, err := handler(ctx, req)
+		observeRPC(info.FullMethod, start, err)
+		return resp, err
+	}
+}
+
+// Stream latency is measured until the stream is closed
+func StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		start := time.Now()
+		err := handler(srv, ss)
+		observeRPC(info.FullMethod, start, err)
+		return err
+	}
+}
+
+func CreateServer(addr string) *http.Server {
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", promhttp.Handler())
+	return &http.Server{Addr: addr, Handler: mux}
+}
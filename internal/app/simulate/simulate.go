@@ -0,0 +1,197 @@
+package simulate
+
+import (
+	"fmt"
+	"io"
+	"math/rand"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/GandarfHSE/go-mafia/internal/engine"
+	"github.com/GandarfHSE/go-mafia/internal/utils/i18n"
+)
+
+type Config struct {
+	Games int
+	// Role of every seat, seats are shuffled before each game
+	Roles []string
+	Town  Strategy
+	Mafia Strategy
+	Seed  int64
+}
+
+type Report struct {
+	Games   int
+	Wins    map[string]int
+	Days    int
+	Actions int
+	// Players of the role alive at the end of game and total number of them
+	Survived map[string]int
+	Total    map[string]int
+}
+
+// ParseRoles builds role set of given size from spec like "maf=2,com=1", other seats are civilians
+func ParseRoles(players int, spec string) ([]string, error) {
+	roles := make([]string, 0)
+	for _, part := range strings.Split(spec, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+		role, cnt, found := strings.Cut(part, "=")
+		n := 1
+		if found {
+			var err error
+			n, err = strconv.Atoi(cnt)
+			if err != nil || n < 0 {
+				return nil, fmt.Errorf("bad count of role %v", role)
+			}
+		}
+		for i := 0; i < n; i++ {
+			roles = append(roles, role)
+		}
+	}
+	if len(roles) > players {
+		return nil, fmt.Errorf("%v roles don't fit into %v players", len(roles), players)
+	}
+	for len(roles) < players {
+		roles = append(roles, engine.RoleCivilian)
+	}
+	return roles, nil
+}
+
+// Run plays headless games on the real rules engine
+func Run(cfg Config) (*Report, error) {
+	rnd := rand.New(rand.NewSource(cfg.Seed))
+	r := &Report{
+		Games:    cfg.Games,
+		Wins:     make(map[string]int),
+		Survived: make(map[string]int),
+		Total:    make(map[string]int),
+	}
+
+	for g := 0; g < cfg.Games; g++ {
+		st, actions, err := playGame(cfg, rnd)
+		if err != nil {
+			return nil, fmt.Errorf("game #%v: %w", g+1, err)
+		}
+		r.Wins[st.Winner] += 1
+		r.Days += st.Day
+		r.Actions += actions
+		for _, p := range st.Players {
+			r.Total[p.Role] += 1
+			if p.Alive {
+				r.Survived[p.Role] += 1
+			}
+		}
+	}
+	return r, nil
+}
+
+func playGame(cfg Config, rnd *rand.Rand) (*engine.State, int, error) {
+	names := make([]string, len(cfg.Roles))
+	roles := make([]string, len(cfg.Roles))
+	for i, j := range rnd.Perm(len(cfg.Roles)) {
+		names[i] = fmt.Sprintf("bot%v", i+1)
+		roles[i] = cfg.Roles[j]
+	}
+	st, err := engine.NewGame(names, roles)
+	if err != nil {
+		return nil, 0, err
+	}
+	st, _, err = engine.Apply(st, engine.Start{})
+	if err != nil {
+		return nil, 0, err
+	}
+
+	// Every day somebody is jailed, so game can't be longer, unless the rules are broken
+	maxActions := 4 * len(roles) * len(roles)
+	actions := 0
+	for st.Phase != engine.PhaseEnded {
+		if actions >= maxActions {
+			st, _, err = engine.Apply(st, engine.ForceEnd{})
+			return st, actions, err
+		}
+		st, _, err = engine.Apply(st, nextAction(cfg, st, rnd))
+		if err != nil {
+			return nil, actions, err
+		}
+		actions += 1
+	}
+	return st, actions, nil
+}
+
+func nextAction(cfg Config, st *engine.State, rnd *rand.Rand) engine.Action {
+	strategy := func(pid int) Strategy {
+		if mafia(st.Players[pid].Role) {
+			return cfg.Mafia
+		}
+		return cfg.Town
+	}
+
+	if st.Phase == engine.PhaseDay {
+		for _, pid := range rnd.Perm(len(st.Players)) {
+			if st.Players[pid].Alive && st.Votes[pid] == engine.NoVote {
+				return engine.Vote{Voter: pid, Target: strategy(pid).Vote(st, pid, rnd)}
+			}
+		}
+	}
+
+	for pid := range st.Players {
+		for _, o := range st.Options(pid) {
+			switch o.Kind {
+			case engine.ActionCheck:
+				return engine.Check{Checker: pid, Target: strategy(pid).Check(st, pid, rnd)}
+			case engine.ActionKill:
+				return engine.Kill{Killer: pid, Target: strategy(pid).Kill(st, pid, rnd)}
+			default:
+				// strategies don't know other night actions, e.g. seeking of Don, they are made at random
+				if engine.IsNightAction(o.Kind) {
+					return engine.Act{Kind: o.Kind, Actor: pid, Targets: []int{pick(rnd, o.Targets)}}
+				}
+			}
+		}
+	}
+	return engine.ForceEnd{}
+}
+
+// targets returns valid targets of the action, if player can make it now
+func targets(st *engine.State, pid int, kind string) []int {
+	for _, o := range st.Options(pid) {
+		if o.Kind == kind {
+			return o.Targets
+		}
+	}
+	return nil
+}
+
+func (r *Report) Print(w io.Writer, tr *i18n.Printer) {
+	percent := func(n int, total int) float64 {
+		if total == 0 {
+			return 0
+		}
+		return 100 * float64(n) / float64(total)
+	}
+
+	fmt.Fprintln(w, tr.T("simulate.games", r.Games))
+	fmt.Fprintln(w, tr.T("simulate.wins"))
+	fmt.Fprintf(w, "  %-9v %5.1f%%\n", tr.T("simulate.town"), percent(r.Wins[engine.TeamTown.ID], r.Games))
+	fmt.Fprintf(w, "  %-9v %5.1f%%\n", tr.T("simulate.mafia"), percent(r.Wins[engine.TeamMafia.ID], r.Games))
+	if r.Wins[engine.WinnerNone] > 0 {
+		fmt.Fprintf(w, "  %-9v %5.1f%%\n", tr.T("simulate.draw"), percent(r.Wins[engine.WinnerNone], r.Games))
+	}
+	if r.Games > 0 {
+		fmt.Fprintln(w, tr.T("simulate.length", float64(r.Days)/float64(r.Games), float64(r.Actions)/float64(r.Games)))
+	}
+
+	roles := make([]string, 0)
+	for role := range r.Total {
+		roles = append(roles, role)
+	}
+	sort.Strings(roles)
+	fmt.Fprintln(w, tr.T("simulate.survived"))
+	for _, role := range roles {
+		fmt.Fprintf(w, "  %-4v %5.1f%%\n", role, percent(r.Survived[role], r.Total[role]))
+	}
+}
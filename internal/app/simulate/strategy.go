@@ -0,0 +1,126 @@
+package simulate
+
+import (
+	"fmt"
+	"math/rand"
+
+	"github.com/GandarfHSE/go-mafia/internal/engine"
+)
+
+// Strategy makes moves for a bot. It gets the whole state, but must use only
+// what player pid knows: public info, own votes and State.KnownRole.
+type Strategy interface {
+	Vote(st *engine.State, pid int, rnd *rand.Rand) int
+	Kill(st *engine.State, pid int, rnd *rand.Rand) int
+	Check(st *engine.State, pid int, rnd *rand.Rand) int
+}
+
+var strategies = map[string]Strategy{
+	"random": randomStrategy{},
+	"smart":  smartStrategy{},
+}
+
+func GetStrategy(name string) (Strategy, error) {
+	s, ok := strategies[name]
+	if !ok {
+		return nil, fmt.Errorf("unknown strategy %v", name)
+	}
+	return s, nil
+}
+
+// randomStrategy picks uniformly among players who are not known allies
+type randomStrategy struct{}
+
+func (randomStrategy) Vote(st *engine.State, pid int, rnd *rand.Rand) int {
+	return pick(rnd, suspects(st, pid))
+}
+
+func (randomStrategy) Kill(st *engine.State, pid int, rnd *rand.Rand) int {
+	return pick(rnd, targets(st, pid, engine.ActionKill))
+}
+
+func (randomStrategy) Check(st *engine.State, pid int, rnd *rand.Rand) int {
+	return pick(rnd, targets(st, pid, engine.ActionCheck))
+}
+
+// smartStrategy coordinates the side:
+// mafia votes together, commissar votes for found mafia and never checks twice,
+// civilians join the leading accusation.
+type smartStrategy struct{}
+
+func (smartStrategy) Vote(st *engine.State, pid int, rnd *rand.Rand) int {
+	candidates := suspects(st, pid)
+	switch role := st.Players[pid].Role; {
+	case mafia(role):
+		for i, p := range st.Players {
+			if i != pid && p.Alive && mafia(st.KnownRole(pid, i)) && st.Votes[i] >= 0 {
+				return st.Votes[i]
+			}
+		}
+	case role == engine.RoleCommissar:
+		for _, i := range candidates {
+			if mafia(st.KnownRole(pid, i)) {
+				return i
+			}
+		}
+	default:
+		leader := -1
+		for _, i := range candidates {
+			if st.VotesCount[i] > 0 && (leader == -1 || st.VotesCount[i] > st.VotesCount[leader]) {
+				leader = i
+			}
+		}
+		if leader != -1 {
+			return leader
+		}
+	}
+	return pick(rnd, candidates)
+}
+
+func (smartStrategy) Kill(st *engine.State, pid int, rnd *rand.Rand) int {
+	return pick(rnd, targets(st, pid, engine.ActionKill))
+}
+
+// engine doesn't allow to check one player twice
+func (smartStrategy) Check(st *engine.State, pid int, rnd *rand.Rand) int {
+	return pick(rnd, targets(st, pid, engine.ActionCheck))
+}
+
+func others(st *engine.State, pid int) []int {
+	res := make([]int, 0)
+	for i, p := range st.Players {
+		if i != pid && p.Alive {
+			res = append(res, i)
+		}
+	}
+	return res
+}
+
+// suspects are alive players who are not known to be on the same side
+func suspects(st *engine.State, pid int) []int {
+	ally := mafia(st.Players[pid].Role)
+	res := make([]int, 0)
+	for _, i := range others(st, pid) {
+		role := st.KnownRole(pid, i)
+		if role == "" || mafia(role) != ally {
+			res = append(res, i)
+		}
+	}
+	if len(res) == 0 {
+		return others(st, pid)
+	}
+	return res
+}
+
+// mafia reports whether role plays for mafia, unknown role doesn't
+func mafia(role string) bool {
+	r := engine.GetRole(role)
+	return r != nil && r.Team() == engine.TeamMafia
+}
+
+func pick(rnd *rand.Rand, pids []int) int {
+	if len(pids) == 0 {
+		return engine.SkipVote
+	}
+	return pids[rnd.Intn(len(pids))]
+}
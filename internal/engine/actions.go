@@ -0,0 +1,365 @@
+package engine
+
+type Action interface {
+	apply(s *State) ([]Event, error)
+}
+
+// Start begins the first day
+type Start struct{}
+
+// Vote at day time, Target is SkipVote to skip voting
+type Vote struct {
+	Voter  int
+	Target int
+}
+
+// Nominate puts up a candidate for the vote at day time, Target is SkipVote to nominate nobody
+type Nominate struct {
+	Nominator int
+	Target    int
+}
+
+// Kill at night time, victim dies at dawn unless protected
+type Kill struct {
+	Killer int
+	Target int
+}
+
+// Check at night time, at dawn result is saved in State.Checks
+type Check struct {
+	Checker int
+	Target  int
+}
+
+// Act is any action listed by State.Options, Targets are pids
+type Act struct {
+	Kind    string
+	Actor   int
+	Targets []int
+}
+
+// Pass ends last words of the speaker before time is over
+type Pass struct {
+	Player int
+}
+
+// EndLastWords is applied when time for last words is over
+type EndLastWords struct{}
+
+// EndSpeech is applied when time of the current speaker is over
+type EndSpeech struct{}
+
+// EndMeeting is applied when time for the meeting of mafia is over
+type EndMeeting struct{}
+
+// Foul is given for breaking the rules, too many fouls remove player from the game
+type Foul struct {
+	Player int
+}
+
+// BestMove names players whom the first night victim suspects
+type BestMove struct {
+	Player  int
+	Targets []int
+}
+
+// Leave is used when player exits or is kicked, player dies
+type Leave struct {
+	Player int
+}
+
+// ForceEnd stops game without winner
+type ForceEnd struct{}
+
+func (a Start) apply(s *State) ([]Event, error) {
+	if s.Phase != PhaseNotStarted {
+		return nil, ErrAlreadyStarted
+	}
+	// lone mafia has nobody to meet
+	if s.Settings.Meeting && s.mafiaCount() > 1 {
+		return s.startMeeting(), nil
+	}
+	return s.startDay(), nil
+}
+
+func (a Vote) apply(s *State) ([]Event, error) {
+	phase := PhaseDay
+	if s.Settings.Nominations {
+		phase = PhaseVoting
+	}
+	if err := s.checkActor(a.Voter, phase, ""); err != nil {
+		return nil, err
+	}
+	if a.Target != SkipVote {
+		if err := s.checkTarget(a.Target); err != nil {
+			return nil, err
+		}
+		if s.Settings.Nominations && !s.Nominated(a.Target) {
+			return nil, ErrWrongTarget
+		}
+	}
+	if s.Votes[a.Voter] != NoVote {
+		return nil, ErrAlreadyVoted
+	}
+	if voter := s.Voter(); voter != -1 && voter != a.Voter {
+		return nil, ErrNotYourTurn
+	}
+
+	s.Votes[a.Voter] = a.Target
+	if a.Target != SkipVote {
+		s.VotesCount[a.Target] += 1
+	}
+	events := []Event{Voted{Voter: a.Voter, Target: a.Target}}
+	events = append(events, s.advance()...)
+	if s.Phase == PhaseVoting {
+		events = append(events, s.voteTurn()...)
+	}
+	return events, nil
+}
+
+func (a Nominate) apply(s *State) ([]Event, error) {
+	if !s.Settings.Nominations {
+		return nil, ErrUnknownAction
+	}
+	if err := s.checkActor(a.Nominator, PhaseDay, ""); err != nil {
+		return nil, err
+	}
+	if s.Settings.Speeches && s.Speaker() != a.Nominator {
+		return nil, ErrNotYourTurn
+	}
+	if a.Target != SkipVote {
+		if err := s.checkTarget(a.Target); err != nil {
+			return nil, err
+		}
+		if a.Target == a.Nominator || s.Nominated(a.Target) {
+			return nil, ErrWrongTarget
+		}
+	}
+	if s.Nominations[a.Nominator] != NoVote {
+		return nil, ErrAlreadyNominated
+	}
+
+	s.Nominations[a.Nominator] = a.Target
+	if a.Target != SkipVote {
+		s.Nominees = append(s.Nominees, a.Target)
+	}
+	events := []Event{Nominated{Nominator: a.Nominator, Target: a.Target}}
+	return append(events, s.advance()...), nil
+}
+
+func (a Kill) apply(s *State) ([]Event, error) {
+	return s.submit(NightAction{Kind: ActionKill, Actor: a.Killer, Target: a.Target})
+}
+
+func (a Check) apply(s *State) ([]Event, error) {
+	return s.submit(NightAction{Kind: ActionCheck, Actor: a.Checker, Target: a.Target})
+}
+
+func (a Act) apply(s *State) ([]Event, error) {
+	switch a.Kind {
+	case ActionPass:
+		return Pass{Player: a.Actor}.apply(s)
+	case ActionBestMove:
+		return BestMove{Player: a.Actor, Targets: a.Targets}.apply(s)
+	}
+	if len(a.Targets) != 1 {
+		return nil, ErrTargetsCount
+	}
+	switch a.Kind {
+	case ActionVote:
+		return Vote{Voter: a.Actor, Target: a.Targets[0]}.apply(s)
+	case ActionNominate:
+		return Nominate{Nominator: a.Actor, Target: a.Targets[0]}.apply(s)
+	}
+	return s.submit(NightAction{Kind: a.Kind, Actor: a.Actor, Target: a.Targets[0]})
+}
+
+func (a Pass) apply(s *State) ([]Event, error) {
+	if !s.valid(a.Player) {
+		return nil, ErrUnknownPlayer
+	}
+	if s.Phase == PhaseEnded {
+		return nil, ErrGameOver
+	}
+	if s.Speaker() == a.Player {
+		return s.nextSpeech(), nil
+	}
+	if !s.Speaking(a.Player) {
+		return nil, ErrWrongPhase
+	}
+	s.silence(a.Player)
+	return s.advance(), nil
+}
+
+func (a EndLastWords) apply(s *State) ([]Event, error) {
+	if s.Phase == PhaseEnded {
+		return nil, ErrGameOver
+	}
+	if s.Phase != PhaseLastWords {
+		return nil, ErrWrongPhase
+	}
+	return s.finishLastWords(), nil
+}
+
+func (a EndSpeech) apply(s *State) ([]Event, error) {
+	if s.Phase == PhaseEnded {
+		return nil, ErrGameOver
+	}
+	if s.Speaker() == -1 {
+		return nil, ErrWrongPhase
+	}
+	return s.nextSpeech(), nil
+}
+
+func (a EndMeeting) apply(s *State) ([]Event, error) {
+	if s.Phase == PhaseEnded {
+		return nil, ErrGameOver
+	}
+	if s.Phase != PhaseMeeting {
+		return nil, ErrWrongPhase
+	}
+	return s.finishMeeting(), nil
+}
+
+func (a Foul) apply(s *State) ([]Event, error) {
+	if !s.valid(a.Player) {
+		return nil, ErrUnknownPlayer
+	}
+	if s.Settings.FoulLimit == 0 {
+		return nil, ErrUnknownAction
+	}
+	if s.Phase == PhaseEnded {
+		return nil, ErrGameOver
+	}
+	if s.Phase == PhaseNotStarted {
+		return nil, ErrWrongPhase
+	}
+	if !s.Players[a.Player].Alive {
+		return nil, ErrDeadPlayer
+	}
+
+	s.Fouls[a.Player] += 1
+	events := []Event{Fouled{Player: a.Player, Count: s.Fouls[a.Player]}}
+	if s.Fouls[a.Player] < s.Settings.FoulLimit {
+		return events, nil
+	}
+	events = append(events, PlayerRemoved{Player: a.Player})
+	return append(events, s.remove(a.Player)...), nil
+}
+
+func (a BestMove) apply(s *State) ([]Event, error) {
+	if !s.valid(a.Player) {
+		return nil, ErrUnknownPlayer
+	}
+	if s.Phase == PhaseEnded {
+		return nil, ErrGameOver
+	}
+	if !s.BestMoveOpen || s.BestMover != a.Player {
+		return nil, ErrWrongPhase
+	}
+	if len(a.Targets) == 0 || len(a.Targets) > BestMoveSize {
+		return nil, ErrBestMoveSize
+	}
+	for i, t := range a.Targets {
+		if err := s.checkTarget(t); err != nil {
+			return nil, err
+		}
+		if contains(a.Targets[:i], t) {
+			return nil, ErrWrongTarget
+		}
+	}
+
+	s.BestMove = append([]int(nil), a.Targets...)
+	s.BestMoveOpen = false
+	return []Event{BestMoveMade{Player: a.Player, Targets: s.BestMove}}, nil
+}
+
+func (a Leave) apply(s *State) ([]Event, error) {
+	if !s.valid(a.Player) {
+		return nil, ErrUnknownPlayer
+	}
+	if s.Phase == PhaseEnded {
+		return nil, ErrGameOver
+	}
+	return s.remove(a.Player), nil
+}
+
+// remove kills player and cancels everything they were doing in the current phase
+func (s *State) remove(pid int) []Event {
+	voter := s.Voter()
+	speaker := s.Speaker()
+	events := s.kill(pid)
+	if s.Phase == PhaseNotStarted {
+		return events
+	}
+	// Vote of the leaving player doesn't count anymore
+	if (s.Phase == PhaseDay || s.Phase == PhaseVoting) && s.Votes[pid] >= 0 {
+		s.VotesCount[s.Votes[pid]] -= 1
+		s.Votes[pid] = NoVote
+	}
+	if s.Phase == PhaseDay || s.Phase == PhaseVoting {
+		// Players who voted against the leaving player vote again
+		for i := range s.Votes {
+			if s.Votes[i] == pid {
+				s.Votes[i] = NoVote
+				events = append(events, VoteReset{Voter: i})
+			}
+		}
+		s.VotesCount[pid] = 0
+		s.Nominees = without(s.Nominees, pid)
+		s.Queue = without(s.Queue, pid)
+	}
+	if s.Phase == PhaseNight {
+		s.withdraw(pid)
+	}
+	if s.Phase == PhaseLastWords || s.Phase == PhaseMeeting {
+		s.silence(pid)
+	}
+	if winner := s.winner(); winner != "" {
+		return append(events, s.end(winner)...)
+	}
+	events = append(events, s.advance()...)
+	if s.Phase == PhaseVoting && s.Voter() != voter {
+		events = append(events, s.voteTurn()...)
+	}
+	if speaker == pid && s.Speaker() != -1 {
+		events = append(events, SpeechStarted{Player: s.Speaker()})
+	}
+	return events
+}
+
+func (a ForceEnd) apply(s *State) ([]Event, error) {
+	if s.Phase == PhaseEnded {
+		return nil, ErrGameOver
+	}
+	return s.end(WinnerNone), nil
+}
+
+func (s *State) checkActor(pid int, phase Phase, role string) error {
+	if !s.valid(pid) {
+		return ErrUnknownPlayer
+	}
+	if s.Phase == PhaseEnded {
+		return ErrGameOver
+	}
+	if s.Phase != phase {
+		return ErrWrongPhase
+	}
+	if !s.Players[pid].Alive {
+		return ErrDeadPlayer
+	}
+	if role != "" && s.Players[pid].Role != role {
+		return ErrWrongRole
+	}
+	return nil
+}
+
+func (s *State) checkTarget(pid int) error {
+	if !s.valid(pid) {
+		return ErrUnknownPlayer
+	}
+	if !s.Players[pid].Alive {
+		return ErrTargetDead
+	}
+	return nil
+}
@@ -0,0 +1,293 @@
+package engine
+
+import (
+	"errors"
+)
+
+var (
+	ErrUnknownPlayer    = errors.New("player not found")
+	ErrDeadPlayer       = errors.New("the dead can't act")
+	ErrTargetDead       = errors.New("target is already dead")
+	ErrWrongPhase       = errors.New("action is not allowed in this phase")
+	ErrWrongRole        = errors.New("role doesn't allow this action")
+	ErrAlreadyVoted     = errors.New("already voted")
+	ErrAlreadyNominated = errors.New("already nominated")
+	ErrNotYourTurn      = errors.New("not your turn")
+	ErrBestMoveSize     = errors.New("best move needs one to three targets")
+	ErrAlreadyKilled    = errors.New("already killed")
+	ErrAlreadyChecked   = errors.New("already checked")
+	ErrAlreadyStarted   = errors.New("game has already started")
+	ErrUnknownAction    = errors.New("unknown action")
+	ErrWrongTarget      = errors.New("target can't be chosen")
+	ErrTargetsCount     = errors.New("exactly one target is required")
+	ErrGameOver         = errors.New("game is over")
+)
+
+// Apply returns the state after action and events it caused.
+// Passed state is never modified, so it stays valid if action is rejected.
+func Apply(s *State, a Action) (*State, []Event, error) {
+	next := s.Clone()
+	events, err := a.apply(next)
+	if err != nil {
+		return s, nil, err
+	}
+	return next, events, nil
+}
+
+func (s *State) startDay() []Event {
+	s.Phase = PhaseDay
+	s.Day += 1
+	s.Nominations = make([]int, len(s.Players))
+	s.Nominees = nil
+	for i := range s.Votes {
+		s.Votes[i] = NoVote
+		s.VotesCount[i] = 0
+		s.Nominations[i] = NoVote
+	}
+	events := []Event{PhaseChanged{Phase: PhaseDay, Day: s.Day}}
+	if s.Settings.Speeches {
+		s.Queue = s.speechOrder()
+		events = append(events, SpeechStarted{Player: s.Queue[0]})
+	}
+	return events
+}
+
+// speechOrder lists alive players in seat order, every day the next seat speaks first
+func (s *State) speechOrder() []int {
+	res := make([]int, 0)
+	for i := range s.Players {
+		pid := (s.Day - 1 + i) % len(s.Players)
+		if s.Players[pid].Alive {
+			res = append(res, pid)
+		}
+	}
+	return res
+}
+
+// nextSpeech gives the floor to the next speaker, the day goes on when everybody has spoken
+func (s *State) nextSpeech() []Event {
+	s.Queue = s.Queue[1:]
+	if len(s.Queue) > 0 {
+		return []Event{SpeechStarted{Player: s.Queue[0]}}
+	}
+	return s.advance()
+}
+
+func (s *State) startNight() []Event {
+	s.Phase = PhaseNight
+	s.Night = nil
+	s.BestMoveOpen = false
+	return []Event{PhaseChanged{Phase: PhaseNight, Day: s.Day}}
+}
+
+// Mafia gets acquainted before the first day, all of them talk at once
+func (s *State) startMeeting() []Event {
+	s.Phase = PhaseMeeting
+	s.Speakers = nil
+	for i, p := range s.Players {
+		if p.Alive && GetRole(p.Role).Team() == TeamMafia {
+			s.Speakers = append(s.Speakers, i)
+		}
+	}
+	return []Event{PhaseChanged{Phase: PhaseMeeting, Day: s.Day}}
+}
+
+func (s *State) end(winner string) []Event {
+	events := make([]Event, 0)
+	if len(s.BestMove) > 0 {
+		guessed := 0
+		for _, pid := range s.BestMove {
+			if GetRole(s.Players[pid].Role).Team() == TeamMafia {
+				guessed += 1
+			}
+		}
+		events = append(events, BestMoveScored{Player: s.BestMover, Guessed: guessed})
+	}
+	s.Phase = PhaseEnded
+	s.Winner = winner
+	s.BestMoveOpen = false
+	return append(events, GameEnded{Winner: winner})
+}
+
+func (s *State) kill(pid int) []Event {
+	if !s.Players[pid].Alive {
+		return nil
+	}
+	s.Players[pid].Alive = false
+	return []Event{PlayerDied{Player: pid}}
+}
+
+func (s *State) startLastWords(speakers []int, next Phase) []Event {
+	s.Phase = PhaseLastWords
+	s.Speakers = append([]int(nil), speakers...)
+	s.NextPhase = next
+	return []Event{PhaseChanged{Phase: PhaseLastWords, Day: s.Day}}
+}
+
+// silence ends last words of player pid
+func (s *State) silence(pid int) {
+	s.Speakers = without(s.Speakers, pid)
+}
+
+// next ends the game if somebody has won, otherwise starts the phase
+func (s *State) next(phase Phase) []Event {
+	if winner := s.winner(); winner != "" {
+		return s.end(winner)
+	}
+	if phase == PhaseDay {
+		return s.startDay()
+	}
+	return s.startNight()
+}
+
+// advance finishes current phase if everybody has made their move
+func (s *State) advance() []Event {
+	switch s.Phase {
+	case PhaseDay:
+		if s.Settings.Speeches {
+			if len(s.Queue) > 0 {
+				return nil
+			}
+			return s.finishNominations()
+		}
+		if s.Settings.Nominations {
+			for i, p := range s.Players {
+				if p.Alive && s.Nominations[i] == NoVote {
+					return nil
+				}
+			}
+			return s.finishNominations()
+		}
+		for i, p := range s.Players {
+			if p.Alive && s.Votes[i] == NoVote {
+				return nil
+			}
+		}
+		return s.finishDay()
+	case PhaseVoting:
+		// all nominees may leave the game before voting is over
+		if len(s.Nominees) > 0 {
+			for i, p := range s.Players {
+				if p.Alive && s.Votes[i] == NoVote {
+					return nil
+				}
+			}
+		}
+		return s.finishVoting()
+	case PhaseNight:
+		if !s.nightDone() {
+			return nil
+		}
+		return s.finishNight()
+	case PhaseLastWords:
+		if len(s.Speakers) > 0 {
+			return nil
+		}
+		return s.finishLastWords()
+	case PhaseMeeting:
+		if len(s.Speakers) > 0 {
+			return nil
+		}
+		return s.finishMeeting()
+	}
+	return nil
+}
+
+func (s *State) finishDay() []Event {
+	events := make([]Event, 0)
+	jailed := -1
+	for i, p := range s.Players {
+		if !p.Alive {
+			continue
+		}
+		if jailed == -1 || s.VotesCount[i] > s.VotesCount[jailed] {
+			jailed = i
+		}
+	}
+	if jailed == -1 || s.VotesCount[jailed] == 0 {
+		events = append(events, NoJail{})
+		return append(events, s.next(PhaseNight)...)
+	}
+	return append(events, s.jail(jailed)...)
+}
+
+func (s *State) jail(pid int) []Event {
+	events := s.kill(pid)
+	events = append(events, PlayerJailed{Player: pid})
+	if s.Settings.LastWords {
+		return append(events, s.startLastWords([]int{pid}, PhaseNight)...)
+	}
+	return append(events, s.next(PhaseNight)...)
+}
+
+// Without nominees there is nothing to vote for, the day is over
+func (s *State) finishNominations() []Event {
+	if len(s.Nominees) == 0 {
+		return append([]Event{NoJail{}}, s.next(PhaseNight)...)
+	}
+	s.Phase = PhaseVoting
+	events := []Event{PhaseChanged{Phase: PhaseVoting, Day: s.Day}}
+	return append(events, s.voteTurn()...)
+}
+
+// voteTurn tells whose turn it is when votes are cast in seat order
+func (s *State) voteTurn() []Event {
+	if voter := s.Voter(); voter != -1 {
+		return []Event{VoteTurn{Player: voter}}
+	}
+	return nil
+}
+
+// Nominee with the most votes is jailed, nobody is jailed on a tie
+func (s *State) finishVoting() []Event {
+	events := make([]Event, 0)
+	jailed, tie := -1, false
+	for _, pid := range s.Nominees {
+		events = append(events, VoteResult{Nominee: pid, Votes: s.VotesCount[pid]})
+		switch {
+		case jailed == -1 || s.VotesCount[pid] > s.VotesCount[jailed]:
+			jailed, tie = pid, false
+		case s.VotesCount[pid] == s.VotesCount[jailed]:
+			tie = true
+		}
+	}
+	if jailed == -1 || tie || s.VotesCount[jailed] == 0 {
+		events = append(events, NoJail{})
+		return append(events, s.next(PhaseNight)...)
+	}
+	return append(events, s.jail(jailed)...)
+}
+
+func (s *State) mafiaCount() int {
+	cnt := 0
+	for _, p := range s.Players {
+		if p.Alive && GetRole(p.Role).Team() == TeamMafia {
+			cnt += 1
+		}
+	}
+	return cnt
+}
+
+func (s *State) finishMeeting() []Event {
+	s.Speakers = nil
+	return s.next(PhaseDay)
+}
+
+func (s *State) finishNight() []Event {
+	events, victims := s.resolveNight()
+	// night of the first day is the first one with kills
+	if s.Settings.BestMove && s.Day == 1 && len(victims) > 0 {
+		s.BestMover = victims[0]
+		s.BestMoveOpen = true
+	}
+	if s.Settings.NightLastWords && len(victims) > 0 {
+		return append(events, s.startLastWords(victims, PhaseDay)...)
+	}
+	return append(events, s.next(PhaseDay)...)
+}
+
+// Victory is checked only after last words, so the condemned always have their say
+func (s *State) finishLastWords() []Event {
+	s.Speakers = nil
+	return s.next(s.NextPhase)
+}
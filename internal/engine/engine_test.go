@@ -0,0 +1,866 @@
+package engine
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+// classic is the smallest game where one jail or kill doesn't end it
+var classic = []string{RoleCivilian, RoleCivilian, RoleCivilian, RoleCommissar, RoleMafia}
+
+type applyCase struct {
+	name     string
+	settings Settings
+	actions  []Action
+	// Events of the last action
+	want    []Event
+	wantErr error
+	// Optional check of the state after the last action
+	check func(t *testing.T, s *State)
+}
+
+// runCases plays actions of every case in a new game, only the last action may fail
+func runCases(t *testing.T, roles []string, cases []applyCase) {
+	t.Helper()
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			s := newGame(t, c.settings, roles)
+			last := len(c.actions) - 1
+			s = mustApply(t, s, c.actions[:last]...)
+
+			next, events, err := Apply(s, c.actions[last])
+			if c.wantErr != nil {
+				if !errors.Is(err, c.wantErr) {
+					t.Fatalf("got error %v, want %v", err, c.wantErr)
+				}
+				if next != s {
+					t.Fatal("rejected action changed the state")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(events, c.want) {
+				t.Fatalf("got events %#v\nwant %#v", events, c.want)
+			}
+			if c.check != nil {
+				c.check(t, next)
+			}
+		})
+	}
+}
+
+func newGame(t *testing.T, settings Settings, roles []string) *State {
+	t.Helper()
+	names := make([]string, len(roles))
+	for i := range roles {
+		names[i] = fmt.Sprintf("p%v", i)
+	}
+	s, err := NewGame(names, roles)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s.Settings = settings
+	return s
+}
+
+func mustApply(t *testing.T, s *State, actions ...Action) *State {
+	t.Helper()
+	for _, a := range actions {
+		next, _, err := Apply(s, a)
+		if err != nil {
+			t.Fatalf("%#v: %v", a, err)
+		}
+		s = next
+	}
+	return s
+}
+
+// then returns actions followed by more, actions are not modified
+func then(actions []Action, more ...Action) []Action {
+	return append(append([]Action(nil), actions...), more...)
+}
+
+func skipVotes(pids ...int) []Action {
+	res := make([]Action, 0)
+	for _, pid := range pids {
+		res = append(res, Vote{Voter: pid, Target: SkipVote})
+	}
+	return res
+}
+
+func skipNominations(pids ...int) []Action {
+	res := make([]Action, 0)
+	for _, pid := range pids {
+		res = append(res, Nominate{Nominator: pid, Target: SkipVote})
+	}
+	return res
+}
+
+func endSpeeches(n int) []Action {
+	res := make([]Action, 0)
+	for i := 0; i < n; i++ {
+		res = append(res, EndSpeech{})
+	}
+	return res
+}
+
+func TestApplyVote(t *testing.T) {
+	start := []Action{Start{}}
+	runCases(t, classic, []applyCase{
+		{
+			name:    "start",
+			actions: start,
+			want:    []Event{PhaseChanged{Phase: PhaseDay, Day: 1}},
+		},
+		{
+			name:    "start twice",
+			actions: then(start, Start{}),
+			wantErr: ErrAlreadyStarted,
+		},
+		{
+			name:    "vote waits for others",
+			actions: then(start, Vote{Voter: 0, Target: 1}),
+			want:    []Event{Voted{Voter: 0, Target: 1}},
+		},
+		{
+			name: "most votes jail",
+			actions: then(start,
+				Vote{Voter: 0, Target: 1}, Vote{Voter: 1, Target: 0}, Vote{Voter: 2, Target: 1},
+				Vote{Voter: 3, Target: 1}, Vote{Voter: 4, Target: SkipVote}),
+			want: []Event{
+				Voted{Voter: 4, Target: SkipVote},
+				PlayerDied{Player: 1},
+				PlayerJailed{Player: 1},
+				PhaseChanged{Phase: PhaseNight, Day: 1},
+			},
+		},
+		{
+			name:    "everybody skips",
+			actions: then(start, skipVotes(0, 1, 2, 3, 4)...),
+			want:    []Event{Voted{Voter: 4, Target: SkipVote}, NoJail{}, PhaseChanged{Phase: PhaseNight, Day: 1}},
+		},
+		{
+			name: "jailed mafia ends game",
+			actions: then(start,
+				Vote{Voter: 0, Target: 4}, Vote{Voter: 1, Target: 4}, Vote{Voter: 2, Target: 4},
+				Vote{Voter: 3, Target: 4}, Vote{Voter: 4, Target: 0}),
+			want: []Event{
+				Voted{Voter: 4, Target: 0},
+				PlayerDied{Player: 4},
+				PlayerJailed{Player: 4},
+				GameEnded{Winner: TeamTown.ID},
+			},
+			check: func(t *testing.T, s *State) {
+				if s.Phase != PhaseEnded || s.Winner != TeamTown.ID {
+					t.Fatalf("got phase %v and winner %q", s.Phase, s.Winner)
+				}
+			},
+		},
+		{
+			name:    "vote twice",
+			actions: then(start, Vote{Voter: 0, Target: 1}, Vote{Voter: 0, Target: 2}),
+			wantErr: ErrAlreadyVoted,
+		},
+		{
+			name:    "unknown voter",
+			actions: then(start, Vote{Voter: 9, Target: 1}),
+			wantErr: ErrUnknownPlayer,
+		},
+		{
+			name:    "dead target",
+			actions: then(start, Leave{Player: 1}, Vote{Voter: 0, Target: 1}),
+			wantErr: ErrTargetDead,
+		},
+		{
+			name:    "dead voter",
+			actions: then(start, Leave{Player: 1}, Vote{Voter: 1, Target: 0}),
+			wantErr: ErrDeadPlayer,
+		},
+		{
+			name:    "vote before start",
+			actions: []Action{Vote{Voter: 0, Target: 1}},
+			wantErr: ErrWrongPhase,
+		},
+		{
+			name:    "vote at night",
+			actions: then(start, then(skipVotes(0, 1, 2, 3, 4), Vote{Voter: 0, Target: 1})...),
+			wantErr: ErrWrongPhase,
+		},
+		{
+			name: "leaving voter's vote is withdrawn",
+			actions: then(start,
+				Vote{Voter: 0, Target: 1}, Vote{Voter: 2, Target: 1}, Leave{Player: 0}),
+			want: []Event{PlayerDied{Player: 0}},
+			check: func(t *testing.T, s *State) {
+				if s.VotesCount[1] != 1 {
+					t.Fatalf("got %v votes, want 1", s.VotesCount[1])
+				}
+			},
+		},
+		{
+			name:    "leaving player finishes voting",
+			actions: then(start, then(skipVotes(1, 2, 3, 4), Leave{Player: 0})...),
+			want:    []Event{PlayerDied{Player: 0}, NoJail{}, PhaseChanged{Phase: PhaseNight, Day: 1}},
+		},
+		{
+			name:    "votes against leaving player are reset",
+			actions: then(start, Vote{Voter: 0, Target: 1}, Vote{Voter: 2, Target: 1}, Leave{Player: 1}),
+			want:    []Event{PlayerDied{Player: 1}, VoteReset{Voter: 0}, VoteReset{Voter: 2}},
+			check: func(t *testing.T, s *State) {
+				if s.Votes[0] != NoVote || s.Votes[2] != NoVote || s.VotesCount[1] != 0 {
+					t.Fatalf("got votes %v and counts %v", s.Votes, s.VotesCount)
+				}
+			},
+		},
+		{
+			name: "leaving player isn't jailed",
+			actions: then(start,
+				Vote{Voter: 0, Target: 1}, Vote{Voter: 2, Target: 1}, Vote{Voter: 3, Target: 2}, Leave{Player: 1},
+				Vote{Voter: 0, Target: 2}, Vote{Voter: 2, Target: SkipVote}, Vote{Voter: 4, Target: SkipVote}),
+			want: []Event{
+				Voted{Voter: 4, Target: SkipVote},
+				PlayerDied{Player: 2},
+				PlayerJailed{Player: 2},
+				PhaseChanged{Phase: PhaseNight, Day: 1},
+			},
+		},
+		{
+			name:    "force end",
+			actions: then(start, ForceEnd{}),
+			want:    []Event{GameEnded{Winner: WinnerNone}},
+		},
+		{
+			name:    "force end twice",
+			actions: then(start, ForceEnd{}, ForceEnd{}),
+			wantErr: ErrGameOver,
+		},
+	})
+}
+
+func TestApplyNominations(t *testing.T) {
+	settings := Settings{Nominations: true}
+	start := []Action{Start{}}
+	// 4 and 2 are put up for the vote
+	voting := then(start, Nominate{Nominator: 0, Target: 4}, Nominate{Nominator: 1, Target: 2})
+	voting = then(voting, skipNominations(2, 3, 4)...)
+
+	runCases(t, classic, []applyCase{
+		{
+			name:     "nomination waits for others",
+			settings: settings,
+			actions:  then(start, Nominate{Nominator: 0, Target: 4}),
+			want:     []Event{Nominated{Nominator: 0, Target: 4}},
+		},
+		{
+			name:     "voting starts after nominations",
+			settings: settings,
+			actions:  voting,
+			want:     []Event{Nominated{Nominator: 4, Target: SkipVote}, PhaseChanged{Phase: PhaseVoting, Day: 1}},
+		},
+		{
+			name:     "no nominees",
+			settings: settings,
+			actions:  then(start, skipNominations(0, 1, 2, 3, 4)...),
+			want:     []Event{Nominated{Nominator: 4, Target: SkipVote}, NoJail{}, PhaseChanged{Phase: PhaseNight, Day: 1}},
+		},
+		{
+			name:     "nominee with most votes is jailed",
+			settings: settings,
+			actions: then(voting,
+				Vote{Voter: 0, Target: 4}, Vote{Voter: 1, Target: 4}, Vote{Voter: 2, Target: 4},
+				Vote{Voter: 3, Target: 2}, Vote{Voter: 4, Target: 2}),
+			want: []Event{
+				Voted{Voter: 4, Target: 2},
+				VoteResult{Nominee: 4, Votes: 3},
+				VoteResult{Nominee: 2, Votes: 2},
+				PlayerDied{Player: 4},
+				PlayerJailed{Player: 4},
+				GameEnded{Winner: TeamTown.ID},
+			},
+		},
+		{
+			name:     "tie jails nobody",
+			settings: settings,
+			actions: then(voting,
+				Vote{Voter: 0, Target: 4}, Vote{Voter: 1, Target: 4}, Vote{Voter: 2, Target: 2},
+				Vote{Voter: 3, Target: 2}, Vote{Voter: 4, Target: SkipVote}),
+			want: []Event{
+				Voted{Voter: 4, Target: SkipVote},
+				VoteResult{Nominee: 4, Votes: 2},
+				VoteResult{Nominee: 2, Votes: 2},
+				NoJail{},
+				PhaseChanged{Phase: PhaseNight, Day: 1},
+			},
+		},
+		{
+			name:     "no votes jail nobody",
+			settings: settings,
+			actions:  then(voting, skipVotes(0, 1, 2, 3, 4)...),
+			want: []Event{
+				Voted{Voter: 4, Target: SkipVote},
+				VoteResult{Nominee: 4, Votes: 0},
+				VoteResult{Nominee: 2, Votes: 0},
+				NoJail{},
+				PhaseChanged{Phase: PhaseNight, Day: 1},
+			},
+		},
+		{
+			name:     "all nominees leave",
+			settings: settings,
+			actions:  then(start, then(skipNominations(1, 2, 3, 4), Nominate{Nominator: 0, Target: 1}, Leave{Player: 1})...),
+			want:     []Event{PlayerDied{Player: 1}, NoJail{}, PhaseChanged{Phase: PhaseNight, Day: 1}},
+		},
+		{
+			name:     "nominations are disabled",
+			actions:  then(start, Nominate{Nominator: 0, Target: 4}),
+			wantErr:  ErrUnknownAction,
+			settings: Settings{},
+		},
+		{
+			name:     "nominate self",
+			settings: settings,
+			actions:  then(start, Nominate{Nominator: 0, Target: 0}),
+			wantErr:  ErrWrongTarget,
+		},
+		{
+			name:     "nominate nominee",
+			settings: settings,
+			actions:  then(start, Nominate{Nominator: 0, Target: 4}, Nominate{Nominator: 1, Target: 4}),
+			wantErr:  ErrWrongTarget,
+		},
+		{
+			name:     "nominate twice",
+			settings: settings,
+			actions:  then(start, Nominate{Nominator: 0, Target: 4}, Nominate{Nominator: 0, Target: 3}),
+			wantErr:  ErrAlreadyNominated,
+		},
+		{
+			name:     "nominate dead player",
+			settings: settings,
+			actions:  then(start, Leave{Player: 1}, Nominate{Nominator: 0, Target: 1}),
+			wantErr:  ErrTargetDead,
+		},
+		{
+			name:     "vote during nominations",
+			settings: settings,
+			actions:  then(start, Nominate{Nominator: 0, Target: 4}, Vote{Voter: 1, Target: 4}),
+			wantErr:  ErrWrongPhase,
+		},
+		{
+			name:     "nominate during voting",
+			settings: settings,
+			actions:  then(voting, Nominate{Nominator: 0, Target: 3}),
+			wantErr:  ErrWrongPhase,
+		},
+		{
+			name:     "vote for not nominated player",
+			settings: settings,
+			actions:  then(voting, Vote{Voter: 0, Target: 3}),
+			wantErr:  ErrWrongTarget,
+		},
+		{
+			name:     "nominate by act",
+			settings: settings,
+			actions:  then(start, Act{Kind: ActionNominate, Actor: 0, Targets: []int{4}}),
+			want:     []Event{Nominated{Nominator: 0, Target: 4}},
+		},
+		{
+			name:     "act without target",
+			settings: settings,
+			actions:  then(start, Act{Kind: ActionNominate, Actor: 0}),
+			wantErr:  ErrTargetsCount,
+		},
+	})
+}
+
+func TestApplySeatOrderVoting(t *testing.T) {
+	settings := Settings{Nominations: true, SeatOrderVoting: true}
+	voting := then([]Action{Start{}, Nominate{Nominator: 0, Target: 4}}, skipNominations(1, 2, 3, 4)...)
+
+	runCases(t, classic, []applyCase{
+		{
+			name:     "first seat votes first",
+			settings: settings,
+			actions:  voting,
+			want: []Event{
+				Nominated{Nominator: 4, Target: SkipVote},
+				PhaseChanged{Phase: PhaseVoting, Day: 1},
+				VoteTurn{Player: 0},
+			},
+		},
+		{
+			name:     "next seat votes",
+			settings: settings,
+			actions:  then(voting, Vote{Voter: 0, Target: 4}),
+			want:     []Event{Voted{Voter: 0, Target: 4}, VoteTurn{Player: 1}},
+		},
+		{
+			name:     "vote out of turn",
+			settings: settings,
+			actions:  then(voting, Vote{Voter: 1, Target: 4}),
+			wantErr:  ErrNotYourTurn,
+		},
+		{
+			name:     "voter of leaving nominee votes again",
+			settings: settings,
+			actions: then([]Action{Start{}, Nominate{Nominator: 0, Target: 1}, Nominate{Nominator: 1, Target: 2}},
+				then(skipNominations(2, 3, 4), Vote{Voter: 0, Target: 1}, Leave{Player: 1})...),
+			want: []Event{PlayerDied{Player: 1}, VoteReset{Voter: 0}, VoteTurn{Player: 0}},
+		},
+		{
+			name:     "voter leaves",
+			settings: settings,
+			actions:  then(voting, Leave{Player: 0}),
+			want:     []Event{PlayerDied{Player: 0}, VoteTurn{Player: 1}},
+		},
+	})
+}
+
+func TestApplyLastWords(t *testing.T) {
+	settings := Settings{LastWords: true}
+	start := []Action{Start{}}
+	// 1 is jailed
+	jail := then(start,
+		Vote{Voter: 0, Target: 1}, Vote{Voter: 1, Target: 0}, Vote{Voter: 2, Target: 1},
+		Vote{Voter: 3, Target: 1}, Vote{Voter: 4, Target: 1})
+	jailMafia := then(start,
+		Vote{Voter: 0, Target: 4}, Vote{Voter: 1, Target: 4}, Vote{Voter: 2, Target: 4},
+		Vote{Voter: 3, Target: 4}, Vote{Voter: 4, Target: 0})
+	// 0 is killed at night
+	night := then(start, then(skipVotes(0, 1, 2, 3, 4), Kill{Killer: 4, Target: 0}, Check{Checker: 3, Target: 4})...)
+	checked := Investigated{Day: 1, Checker: 3, Target: 4, Role: RoleMafia, Kind: ActionCheck}
+
+	runCases(t, classic, []applyCase{
+		{
+			name:     "jailed player speaks before night",
+			settings: settings,
+			actions:  jail,
+			want: []Event{
+				Voted{Voter: 4, Target: 1},
+				PlayerDied{Player: 1},
+				PlayerJailed{Player: 1},
+				PhaseChanged{Phase: PhaseLastWords, Day: 1},
+			},
+			check: func(t *testing.T, s *State) {
+				if !s.Speaking(1) || s.Silenced(1) || !s.Silenced(0) {
+					t.Fatal("only the jailed player may talk")
+				}
+				want := []Option{{Kind: ActionPass, Title: "action.pass", NoTarget: true}}
+				if got := s.Options(1); !reflect.DeepEqual(got, want) {
+					t.Fatalf("got options %+v, want %+v", got, want)
+				}
+			},
+		},
+		{
+			name:    "jailed player is silent by default",
+			actions: jail,
+			want: []Event{
+				Voted{Voter: 4, Target: 1},
+				PlayerDied{Player: 1},
+				PlayerJailed{Player: 1},
+				PhaseChanged{Phase: PhaseNight, Day: 1},
+			},
+		},
+		{
+			name:     "timeout starts night",
+			settings: settings,
+			actions:  then(jail, EndLastWords{}),
+			want:     []Event{PhaseChanged{Phase: PhaseNight, Day: 1}},
+		},
+		{
+			name:     "speaker passes",
+			settings: settings,
+			actions:  then(jail, Pass{Player: 1}),
+			want:     []Event{PhaseChanged{Phase: PhaseNight, Day: 1}},
+		},
+		{
+			name:     "speaker passes by act",
+			settings: settings,
+			actions:  then(jail, Act{Kind: ActionPass, Actor: 1}),
+			want:     []Event{PhaseChanged{Phase: PhaseNight, Day: 1}},
+		},
+		{
+			name:     "listener can't pass",
+			settings: settings,
+			actions:  then(jail, Pass{Player: 0}),
+			wantErr:  ErrWrongPhase,
+		},
+		{
+			name:     "vote during last words",
+			settings: settings,
+			actions:  then(jail, Vote{Voter: 0, Target: 2}),
+			wantErr:  ErrWrongPhase,
+		},
+		{
+			name:     "timeout out of last words",
+			settings: settings,
+			actions:  then(start, EndLastWords{}),
+			wantErr:  ErrWrongPhase,
+		},
+		{
+			name:     "jailed mafia speaks before game end",
+			settings: settings,
+			actions:  jailMafia,
+			want: []Event{
+				Voted{Voter: 4, Target: 0},
+				PlayerDied{Player: 4},
+				PlayerJailed{Player: 4},
+				PhaseChanged{Phase: PhaseLastWords, Day: 1},
+			},
+		},
+		{
+			name:     "victory after last words",
+			settings: settings,
+			actions:  then(jailMafia, EndLastWords{}),
+			want:     []Event{GameEnded{Winner: TeamTown.ID}},
+		},
+		{
+			name:     "night victim is silent by default",
+			settings: settings,
+			actions:  night,
+			want:     []Event{PlayerDied{Player: 0}, checked, PlayerKilled{Player: 0}, PhaseChanged{Phase: PhaseDay, Day: 2}},
+		},
+		{
+			name:     "night victim speaks at dawn",
+			settings: Settings{NightLastWords: true},
+			actions:  night,
+			want:     []Event{PlayerDied{Player: 0}, checked, PlayerKilled{Player: 0}, PhaseChanged{Phase: PhaseLastWords, Day: 1}},
+			check: func(t *testing.T, s *State) {
+				if !s.Speaking(0) || s.NextPhase != PhaseDay {
+					t.Fatalf("got speakers %v and next phase %v", s.Speakers, s.NextPhase)
+				}
+			},
+		},
+		{
+			name:     "day starts after night last words",
+			settings: Settings{NightLastWords: true},
+			actions:  then(night, EndLastWords{}),
+			want:     []Event{PhaseChanged{Phase: PhaseDay, Day: 2}},
+		},
+		{
+			name:     "speaker leaves",
+			settings: settings,
+			actions:  then(jail, Leave{Player: 1}),
+			want:     []Event{PhaseChanged{Phase: PhaseNight, Day: 1}},
+		},
+		{
+			name:     "last words after end",
+			settings: settings,
+			actions:  then(jail, ForceEnd{}, EndLastWords{}),
+			wantErr:  ErrGameOver,
+		},
+	})
+}
+
+func TestApplySpeeches(t *testing.T) {
+	settings := Settings{Nominations: true, Speeches: true}
+	start := []Action{Start{}}
+
+	runCases(t, classic, []applyCase{
+		{
+			name:     "first seat speaks first",
+			settings: settings,
+			actions:  start,
+			want:     []Event{PhaseChanged{Phase: PhaseDay, Day: 1}, SpeechStarted{Player: 0}},
+		},
+		{
+			name:     "speaker nominates",
+			settings: settings,
+			actions:  then(start, Nominate{Nominator: 0, Target: 4}),
+			want:     []Event{Nominated{Nominator: 0, Target: 4}},
+		},
+		{
+			name:     "nominate out of speech",
+			settings: settings,
+			actions:  then(start, Nominate{Nominator: 1, Target: 4}),
+			wantErr:  ErrNotYourTurn,
+		},
+		{
+			name:     "timeout gives floor to next seat",
+			settings: settings,
+			actions:  then(start, EndSpeech{}),
+			want:     []Event{SpeechStarted{Player: 1}},
+		},
+		{
+			name:     "speaker passes",
+			settings: settings,
+			actions:  then(start, Pass{Player: 0}),
+			want:     []Event{SpeechStarted{Player: 1}},
+		},
+		{
+			name:     "listener can't pass",
+			settings: settings,
+			actions:  then(start, Pass{Player: 1}),
+			wantErr:  ErrWrongPhase,
+		},
+		{
+			name:     "voting starts after speeches",
+			settings: settings,
+			actions:  then(start, then([]Action{Nominate{Nominator: 0, Target: 4}}, endSpeeches(5)...)...),
+			want:     []Event{PhaseChanged{Phase: PhaseVoting, Day: 1}},
+		},
+		{
+			name:     "no nominees after speeches",
+			settings: settings,
+			actions:  then(start, endSpeeches(5)...),
+			want:     []Event{NoJail{}, PhaseChanged{Phase: PhaseNight, Day: 1}},
+		},
+		{
+			name:     "next day starts with next seat",
+			settings: settings,
+			actions:  then(start, then(endSpeeches(5), Kill{Killer: 4, Target: 0}, Check{Checker: 3, Target: 2})...),
+			want: []Event{
+				PlayerDied{Player: 0},
+				Investigated{Day: 1, Checker: 3, Target: 2, Role: RoleCivilian, Kind: ActionCheck},
+				PlayerKilled{Player: 0},
+				PhaseChanged{Phase: PhaseDay, Day: 2},
+				SpeechStarted{Player: 1},
+			},
+		},
+		{
+			name:     "speaker leaves",
+			settings: settings,
+			actions:  then(start, Leave{Player: 0}),
+			want:     []Event{PlayerDied{Player: 0}, SpeechStarted{Player: 1}},
+		},
+		{
+			name:     "timeout without speeches",
+			settings: Settings{Nominations: true},
+			actions:  then(start, EndSpeech{}),
+			wantErr:  ErrWrongPhase,
+		},
+	})
+}
+
+func TestApplyFouls(t *testing.T) {
+	settings := Settings{FoulLimit: 2}
+	start := []Action{Start{}}
+
+	runCases(t, classic, []applyCase{
+		{
+			name:     "foul is counted",
+			settings: settings,
+			actions:  then(start, Foul{Player: 0}),
+			want:     []Event{Fouled{Player: 0, Count: 1}},
+		},
+		{
+			name:     "limit removes player",
+			settings: settings,
+			actions:  then(start, Foul{Player: 0}, Foul{Player: 0}),
+			want:     []Event{Fouled{Player: 0, Count: 2}, PlayerRemoved{Player: 0}, PlayerDied{Player: 0}},
+		},
+		{
+			name:     "fouls of different players are separate",
+			settings: settings,
+			actions:  then(start, Foul{Player: 0}, Foul{Player: 1}),
+			want:     []Event{Fouled{Player: 1, Count: 1}},
+		},
+		{
+			name:     "removed voter's vote doesn't count",
+			settings: settings,
+			actions:  then(start, Vote{Voter: 0, Target: 1}, Foul{Player: 0}, Foul{Player: 0}),
+			want:     []Event{Fouled{Player: 0, Count: 2}, PlayerRemoved{Player: 0}, PlayerDied{Player: 0}},
+			check: func(t *testing.T, s *State) {
+				if s.VotesCount[1] != 0 {
+					t.Fatalf("got %v votes, want 0", s.VotesCount[1])
+				}
+			},
+		},
+		{
+			name:     "removal finishes voting",
+			settings: settings,
+			actions:  then(start, then(skipVotes(1, 2, 3, 4), Foul{Player: 0}, Foul{Player: 0})...),
+			want: []Event{
+				Fouled{Player: 0, Count: 2},
+				PlayerRemoved{Player: 0},
+				PlayerDied{Player: 0},
+				NoJail{},
+				PhaseChanged{Phase: PhaseNight, Day: 1},
+			},
+		},
+		{
+			name:     "removal of last mafia ends game",
+			settings: settings,
+			actions:  then(start, Foul{Player: 4}, Foul{Player: 4}),
+			want: []Event{
+				Fouled{Player: 4, Count: 2},
+				PlayerRemoved{Player: 4},
+				PlayerDied{Player: 4},
+				GameEnded{Winner: TeamTown.ID},
+			},
+		},
+		{
+			name:     "fouls are disabled",
+			settings: Settings{},
+			actions:  then(start, Foul{Player: 0}),
+			wantErr:  ErrUnknownAction,
+		},
+		{
+			name:     "foul before start",
+			settings: settings,
+			actions:  []Action{Foul{Player: 0}},
+			wantErr:  ErrWrongPhase,
+		},
+		{
+			name:     "foul of dead player",
+			settings: settings,
+			actions:  then(start, Leave{Player: 0}, Foul{Player: 0}),
+			wantErr:  ErrDeadPlayer,
+		},
+		{
+			name:     "foul of unknown player",
+			settings: settings,
+			actions:  then(start, Foul{Player: 9}),
+			wantErr:  ErrUnknownPlayer,
+		},
+		{
+			name:     "foul after end",
+			settings: settings,
+			actions:  then(start, ForceEnd{}, Foul{Player: 0}),
+			wantErr:  ErrGameOver,
+		},
+	})
+}
+
+func TestApplyMeeting(t *testing.T) {
+	settings := Settings{Meeting: true}
+	roles := []string{RoleCivilian, RoleCivilian, RoleCivilian, RoleCivilian, RoleCommissar, RoleDon, RoleMafia}
+	start := []Action{Start{}}
+
+	runCases(t, roles, []applyCase{
+		{
+			name:     "game starts with meeting",
+			settings: settings,
+			actions:  start,
+			want:     []Event{PhaseChanged{Phase: PhaseMeeting, Day: 0}},
+			check: func(t *testing.T, s *State) {
+				if !s.Speaking(5) || !s.Speaking(6) || !s.Silenced(0) {
+					t.Fatal("only mafia may talk at the meeting")
+				}
+			},
+		},
+		{
+			name:     "timeout starts first day",
+			settings: settings,
+			actions:  then(start, EndMeeting{}),
+			want:     []Event{PhaseChanged{Phase: PhaseDay, Day: 1}},
+		},
+		{
+			name:     "day starts when all mafia pass",
+			settings: settings,
+			actions:  then(start, Pass{Player: 5}, Pass{Player: 6}),
+			want:     []Event{PhaseChanged{Phase: PhaseDay, Day: 1}},
+		},
+		{
+			name:     "civilian can't pass",
+			settings: settings,
+			actions:  then(start, Pass{Player: 0}),
+			wantErr:  ErrWrongPhase,
+		},
+		{
+			name:     "timeout out of meeting",
+			settings: settings,
+			actions:  then(start, EndMeeting{}, EndMeeting{}),
+			wantErr:  ErrWrongPhase,
+		},
+	})
+
+	// Don alone has nobody to meet
+	runCases(t, []string{RoleCivilian, RoleCivilian, RoleCommissar, RoleDon}, []applyCase{
+		{
+			name:     "lone mafia skips meeting",
+			settings: settings,
+			actions:  start,
+			want:     []Event{PhaseChanged{Phase: PhaseDay, Day: 1}},
+		},
+	})
+}
+
+func TestApplyBestMove(t *testing.T) {
+	settings := Settings{BestMove: true}
+	roles := []string{RoleCivilian, RoleCivilian, RoleCivilian, RoleCommissar, RoleMafia, RoleMafia, RoleCivilian}
+	// 0 is killed the first night and makes the best move the next day
+	day2 := then([]Action{Start{}}, skipVotes(0, 1, 2, 3, 4, 5, 6)...)
+	day2 = then(day2, Kill{Killer: 4, Target: 0}, Check{Checker: 3, Target: 1})
+
+	runCases(t, roles, []applyCase{
+		{
+			name:     "victim names suspects",
+			settings: settings,
+			actions:  then(day2, BestMove{Player: 0, Targets: []int{4, 5}}),
+			want:     []Event{BestMoveMade{Player: 0, Targets: []int{4, 5}}},
+		},
+		{
+			name:     "best move by act",
+			settings: settings,
+			actions:  then(day2, Act{Kind: ActionBestMove, Actor: 0, Targets: []int{4}}),
+			want:     []Event{BestMoveMade{Player: 0, Targets: []int{4}}},
+		},
+		{
+			name:     "best move is scored at the end",
+			settings: settings,
+			actions:  then(day2, BestMove{Player: 0, Targets: []int{4, 1, 5}}, ForceEnd{}),
+			want:     []Event{BestMoveScored{Player: 0, Guessed: 2}, GameEnded{Winner: WinnerNone}},
+		},
+		{
+			name:     "best move is made once",
+			settings: settings,
+			actions:  then(day2, BestMove{Player: 0, Targets: []int{4}}, BestMove{Player: 0, Targets: []int{5}}),
+			wantErr:  ErrWrongPhase,
+		},
+		{
+			name:     "only the first victim moves",
+			settings: settings,
+			actions:  then(day2, BestMove{Player: 1, Targets: []int{4}}),
+			wantErr:  ErrWrongPhase,
+		},
+		{
+			name:     "too many targets",
+			settings: settings,
+			actions:  then(day2, BestMove{Player: 0, Targets: []int{1, 2, 4, 5}}),
+			wantErr:  ErrBestMoveSize,
+		},
+		{
+			name:     "no targets",
+			settings: settings,
+			actions:  then(day2, BestMove{Player: 0}),
+			wantErr:  ErrBestMoveSize,
+		},
+		{
+			name:     "repeated target",
+			settings: settings,
+			actions:  then(day2, BestMove{Player: 0, Targets: []int{4, 4}}),
+			wantErr:  ErrWrongTarget,
+		},
+		{
+			name:     "closed at next night",
+			settings: settings,
+			actions:  then(day2, then(skipVotes(1, 2, 3, 4, 5, 6), BestMove{Player: 0, Targets: []int{4}})...),
+			wantErr:  ErrWrongPhase,
+		},
+		{
+			name:     "disabled",
+			settings: Settings{},
+			actions:  then(day2, BestMove{Player: 0, Targets: []int{4}}),
+			wantErr:  ErrWrongPhase,
+		},
+	})
+}
+
+func TestApplyKeepsState(t *testing.T) {
+	s := mustApply(t, newGame(t, Settings{}, classic), Start{})
+	next, _, err := Apply(s, Vote{Voter: 0, Target: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s.Votes[0] != NoVote || s.VotesCount[1] != 0 {
+		t.Fatal("applied action changed the passed state")
+	}
+	if next.Votes[0] != 1 || next.VotesCount[1] != 1 {
+		t.Fatal("vote is not counted in the next state")
+	}
+}
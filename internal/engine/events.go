@@ -0,0 +1,117 @@
+package engine
+
+// Event is emitted by Apply, players are referred by pid
+type Event interface {
+	isEvent()
+}
+
+type PhaseChanged struct {
+	Phase Phase
+	Day   int
+}
+
+type Voted struct {
+	Voter  int
+	Target int
+}
+
+// Nominated is emitted when player puts up a candidate for the vote, Target is SkipVote if nobody
+type Nominated struct {
+	Nominator int
+	Target    int
+}
+
+// VoteTurn is emitted when votes are cast in seat order and next player has to vote
+type VoteTurn struct {
+	Player int
+}
+
+// VoteReset is emitted when the player voted against has left the game and Voter has to vote again
+type VoteReset struct {
+	Voter int
+}
+
+// VoteResult is emitted for every nominee when voting is over
+type VoteResult struct {
+	Nominee int
+	Votes   int
+}
+
+// PlayerDied is emitted whenever player dies, before the public event about it
+type PlayerDied struct {
+	Player int
+}
+
+type PlayerJailed struct {
+	Player int
+}
+
+// NoJail is emitted if nobody got votes
+type NoJail struct{}
+
+// PlayerKilled is emitted at the end of night
+type PlayerKilled struct {
+	Player int
+}
+
+// NoKill is emitted at dawn if nobody was killed
+type NoKill struct{}
+
+// Investigated is emitted at dawn for the checker only, Role is empty if seeking failed
+type Investigated struct {
+	Day     int
+	Checker int
+	Target  int
+	Role    string
+	Kind    string
+}
+
+// SpeechStarted is emitted when the next player takes the floor
+type SpeechStarted struct {
+	Player int
+}
+
+// Fouled is emitted for every foul, Count is how many fouls player has got
+type Fouled struct {
+	Player int
+	Count  int
+}
+
+// PlayerRemoved is emitted when player gets too many fouls, before they die
+type PlayerRemoved struct {
+	Player int
+}
+
+type BestMoveMade struct {
+	Player  int
+	Targets []int
+}
+
+// BestMoveScored is emitted before the end of the game, Guessed is how many mafia players were named
+type BestMoveScored struct {
+	Player  int
+	Guessed int
+}
+
+type GameEnded struct {
+	Winner string
+}
+
+func (PhaseChanged) isEvent()   {}
+func (Voted) isEvent()          {}
+func (Nominated) isEvent()      {}
+func (VoteTurn) isEvent()       {}
+func (VoteReset) isEvent()      {}
+func (VoteResult) isEvent()     {}
+func (PlayerDied) isEvent()     {}
+func (PlayerJailed) isEvent()   {}
+func (NoJail) isEvent()         {}
+func (PlayerKilled) isEvent()   {}
+func (NoKill) isEvent()         {}
+func (Investigated) isEvent()   {}
+func (SpeechStarted) isEvent()  {}
+func (Fouled) isEvent()         {}
+func (PlayerRemoved) isEvent()  {}
+func (BestMoveMade) isEvent()   {}
+func (BestMoveScored) isEvent() {}
+func (GameEnded) isEvent()      {}
@@ -0,0 +1,194 @@
+package engine
+
+import (
+	"sort"
+)
+
+// Night action kinds
+const (
+	ActionBlock   string = "block"
+	ActionProtect string = "protect"
+	ActionKill    string = "kill"
+	ActionCheck   string = "check"
+	// Don seeks the commissar
+	ActionSeek string = "seek"
+)
+
+// Night actions are resolved at dawn, lower priority goes first
+const (
+	PriorityBlock       int = 10
+	PriorityProtect     int = 20
+	PriorityKill        int = 30
+	PriorityInvestigate int = 40
+)
+
+// NightAction is chosen at night and resolved at dawn together with all others,
+// so the order in which players act doesn't matter
+type NightAction struct {
+	Kind   string `json:"kind"`
+	Actor  int    `json:"actor"`
+	Target int    `json:"target"`
+}
+
+// Night is a dawn resolution in progress, resolvers record their effects here
+type Night struct {
+	State     *State
+	Blocked   map[int]bool
+	Protected map[int]bool
+	Victims   []int
+}
+
+// NightRule describes night action of a role
+type NightRule struct {
+	Kind  string
+	Title string
+	// Shown to the actor when action is accepted
+	Confirmation string
+	// Roles having the action, set by RegisterRole
+	Roles    []string
+	Priority int
+	// Team action is made once per night by any alive player having it
+	Team bool
+	// Returned when action is made twice a night
+	Repeated error
+	Resolve  func(n *Night, a NightAction) []Event
+}
+
+var nightRules = make(map[string]NightRule)
+
+// Night actions are registered together with roles, see RegisterRole.
+// Roles sharing the action must describe it the same way.
+func registerNightAction(role string, rule NightRule) {
+	if r, ok := nightRules[rule.Kind]; ok {
+		rule.Roles = r.Roles
+	}
+	rule.Roles = append(append([]string(nil), rule.Roles...), role)
+	nightRules[rule.Kind] = rule
+}
+
+func (r *NightRule) allows(role string) bool {
+	for _, id := range r.Roles {
+		if id == role {
+			return true
+		}
+	}
+	return false
+}
+
+func ResolveBlock(n *Night, a NightAction) []Event {
+	n.Blocked[a.Target] = true
+	return nil
+}
+
+func ResolveProtect(n *Night, a NightAction) []Event {
+	n.Protected[a.Target] = true
+	return nil
+}
+
+func ResolveKill(n *Night, a NightAction) []Event {
+	if n.Protected[a.Target] || !n.State.Players[a.Target].Alive {
+		return nil
+	}
+	n.Victims = append(n.Victims, a.Target)
+	return n.State.kill(a.Target)
+}
+
+func ResolveInvestigate(n *Night, a NightAction) []Event {
+	s := n.State
+	c := CheckRecord{Day: s.Day, Checked: a.Target, Kind: a.Kind}
+	s.Checks = append(s.Checks, c)
+	return []Event{Investigated{Day: s.Day, Checker: a.Actor, Target: a.Target, Role: s.CheckedRole(c), Kind: a.Kind}}
+}
+
+// IsNightAction reports whether action of the kind is resolved at dawn
+func IsNightAction(kind string) bool {
+	_, ok := nightRules[kind]
+	return ok
+}
+
+// Acted reports whether player has made night action of the kind this night.
+// For team actions it reports whether any teammate has made it.
+func (s *State) Acted(pid int, kind string) bool {
+	rule := nightRules[kind]
+	for _, a := range s.Night {
+		if a.Kind == kind && (a.Actor == pid || rule.Team) {
+			return true
+		}
+	}
+	return false
+}
+
+// withdraw cancels night actions of player who left the game
+func (s *State) withdraw(pid int) {
+	kept := make([]NightAction, 0)
+	for _, a := range s.Night {
+		if a.Actor != pid {
+			kept = append(kept, a)
+		}
+	}
+	s.Night = kept
+}
+
+func (s *State) submit(a NightAction) ([]Event, error) {
+	rule, ok := nightRules[a.Kind]
+	if !ok {
+		return nil, ErrUnknownAction
+	}
+	if err := s.checkActor(a.Actor, PhaseNight, ""); err != nil {
+		return nil, err
+	}
+	if !rule.allows(s.Players[a.Actor].Role) {
+		return nil, ErrWrongRole
+	}
+	if err := s.checkTarget(a.Target); err != nil {
+		return nil, err
+	}
+	if s.Acted(a.Actor, a.Kind) {
+		return nil, rule.Repeated
+	}
+	if err := GetRole(s.Players[a.Actor].Role).Validate(s, a); err != nil {
+		return nil, err
+	}
+
+	s.Night = append(s.Night, a)
+	return s.advance(), nil
+}
+
+// nightDone reports whether every alive player has chosen night action,
+// players without valid targets are not waited for
+func (s *State) nightDone() bool {
+	for kind, rule := range nightRules {
+		for i, p := range s.Players {
+			if p.Alive && rule.allows(p.Role) && !s.Acted(i, kind) && len(s.nightTargets(i, &rule)) > 0 {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// resolveNight applies chosen actions by priority and returns victims of the night.
+// Actions of players who die this night still happen, only blocked ones are cancelled.
+func (s *State) resolveNight() ([]Event, []int) {
+	actions := append([]NightAction(nil), s.Night...)
+	sort.SliceStable(actions, func(i, j int) bool {
+		return nightRules[actions[i].Kind].Priority < nightRules[actions[j].Kind].Priority
+	})
+
+	n := &Night{State: s, Blocked: make(map[int]bool), Protected: make(map[int]bool)}
+	events := make([]Event, 0)
+	for _, a := range actions {
+		if n.Blocked[a.Actor] {
+			continue
+		}
+		events = append(events, nightRules[a.Kind].Resolve(n, a)...)
+	}
+
+	if len(n.Victims) == 0 {
+		return append(events, NoKill{}), nil
+	}
+	for _, pid := range n.Victims {
+		events = append(events, PlayerKilled{Player: pid})
+	}
+	return events, n.Victims
+}
@@ -0,0 +1,258 @@
+package engine
+
+import (
+	"errors"
+	"testing"
+)
+
+// Classic roles neither protect nor block, test roles cover these priorities
+const (
+	roleDoctor  string = "test.doc"
+	roleBlocker string = "test.blk"
+)
+
+var errAlreadyActed = errors.New("already acted")
+
+type doctor struct {
+	civilian
+}
+
+func (doctor) ID() string {
+	return roleDoctor
+}
+
+func (doctor) Night() []NightRule {
+	return []NightRule{{Kind: ActionProtect, Priority: PriorityProtect, Repeated: errAlreadyActed, Resolve: ResolveProtect}}
+}
+
+func (doctor) Validate(s *State, a NightAction) error {
+	return nil
+}
+
+type blocker struct {
+	civilian
+}
+
+func (blocker) ID() string {
+	return roleBlocker
+}
+
+func (blocker) Night() []NightRule {
+	return []NightRule{{Kind: ActionBlock, Priority: PriorityBlock, Repeated: errAlreadyActed, Resolve: ResolveBlock}}
+}
+
+func (blocker) Validate(s *State, a NightAction) error {
+	return nil
+}
+
+func init() {
+	RegisterRole(doctor{})
+	RegisterRole(blocker{})
+}
+
+func protect(actor int, target int) Action {
+	return Act{Kind: ActionProtect, Actor: actor, Targets: []int{target}}
+}
+
+func block(actor int, target int) Action {
+	return Act{Kind: ActionBlock, Actor: actor, Targets: []int{target}}
+}
+
+func TestApplyNight(t *testing.T) {
+	roles := []string{RoleCivilian, RoleCivilian, RoleCommissar, RoleMafia, roleDoctor, roleBlocker}
+	night := then([]Action{Start{}}, skipVotes(0, 1, 2, 3, 4, 5)...)
+	// 4 is jailed
+	jail := then([]Action{Start{}},
+		Vote{Voter: 0, Target: 4}, Vote{Voter: 1, Target: 4}, Vote{Voter: 2, Target: 4},
+		Vote{Voter: 3, Target: 4}, Vote{Voter: 4, Target: 0}, Vote{Voter: 5, Target: 4})
+	checked := Investigated{Day: 1, Checker: 2, Target: 3, Role: RoleMafia, Kind: ActionCheck}
+	dawn := PhaseChanged{Phase: PhaseDay, Day: 2}
+
+	runCases(t, roles, []applyCase{
+		{
+			name:    "night waits for everybody",
+			actions: then(night, Kill{Killer: 3, Target: 0}),
+			want:    nil,
+			check: func(t *testing.T, s *State) {
+				if !s.Players[0].Alive {
+					t.Fatal("victim died before dawn")
+				}
+			},
+		},
+		{
+			name:    "victim dies at dawn",
+			actions: then(night, Kill{Killer: 3, Target: 0}, Check{Checker: 2, Target: 3}, protect(4, 1), block(5, 1)),
+			want:    []Event{PlayerDied{Player: 0}, checked, PlayerKilled{Player: 0}, dawn},
+			check: func(t *testing.T, s *State) {
+				if s.Players[0].Alive || len(s.Checks) != 1 {
+					t.Fatalf("got state %+v after dawn", s)
+				}
+			},
+		},
+		{
+			name:    "order of actions doesn't matter",
+			actions: then(night, block(5, 1), protect(4, 1), Check{Checker: 2, Target: 3}, Kill{Killer: 3, Target: 0}),
+			want:    []Event{PlayerDied{Player: 0}, checked, PlayerKilled{Player: 0}, dawn},
+		},
+		{
+			name:    "protected victim survives",
+			actions: then(night, Kill{Killer: 3, Target: 0}, protect(4, 0), Check{Checker: 2, Target: 3}, block(5, 1)),
+			want:    []Event{checked, NoKill{}, dawn},
+		},
+		{
+			name:    "protection is resolved before kill",
+			actions: then(night, protect(4, 0), Check{Checker: 2, Target: 3}, block(5, 1), Kill{Killer: 3, Target: 0}),
+			want:    []Event{checked, NoKill{}, dawn},
+		},
+		{
+			name:    "blocked doctor doesn't protect",
+			actions: then(night, Kill{Killer: 3, Target: 0}, protect(4, 0), Check{Checker: 2, Target: 3}, block(5, 4)),
+			want:    []Event{PlayerDied{Player: 0}, checked, PlayerKilled{Player: 0}, dawn},
+		},
+		{
+			name:    "blocked killer doesn't kill",
+			actions: then(night, Kill{Killer: 3, Target: 0}, protect(4, 1), Check{Checker: 2, Target: 3}, block(5, 3)),
+			want:    []Event{checked, NoKill{}, dawn},
+		},
+		{
+			name:    "blocked commissar doesn't check",
+			actions: then(night, Kill{Killer: 3, Target: 0}, protect(4, 1), Check{Checker: 2, Target: 3}, block(5, 2)),
+			want:    []Event{PlayerDied{Player: 0}, PlayerKilled{Player: 0}, dawn},
+		},
+		{
+			name:    "killed commissar still checks",
+			actions: then(night, Kill{Killer: 3, Target: 2}, protect(4, 1), Check{Checker: 2, Target: 3}, block(5, 1)),
+			want:    []Event{PlayerDied{Player: 2}, checked, PlayerKilled{Player: 2}, dawn},
+		},
+		{
+			name:    "jailed player doesn't act at night",
+			actions: then(jail, Kill{Killer: 3, Target: 0}, Check{Checker: 2, Target: 3}, protect(4, 0)),
+			wantErr: ErrDeadPlayer,
+		},
+		{
+			name:    "night goes on without jailed player",
+			actions: then(jail, Kill{Killer: 3, Target: 0}, Check{Checker: 2, Target: 3}, block(5, 1)),
+			want:    []Event{PlayerDied{Player: 0}, checked, PlayerKilled{Player: 0}, dawn},
+		},
+		{
+			name:    "leaving player's action is cancelled",
+			actions: then(night, Kill{Killer: 3, Target: 0}, protect(4, 0), Check{Checker: 2, Target: 3}, Leave{Player: 4}, block(5, 1)),
+			want:    []Event{PlayerDied{Player: 0}, checked, PlayerKilled{Player: 0}, dawn},
+		},
+		{
+			name:    "leaving mafia ends game",
+			actions: then(night, Leave{Player: 3}),
+			want:    []Event{PlayerDied{Player: 3}, GameEnded{Winner: TeamTown.ID}},
+		},
+		{
+			name:    "kill twice",
+			actions: then(night, Kill{Killer: 3, Target: 0}, Kill{Killer: 3, Target: 1}),
+			wantErr: ErrAlreadyKilled,
+		},
+		{
+			name:    "protect twice",
+			actions: then(night, protect(4, 0), protect(4, 1)),
+			wantErr: errAlreadyActed,
+		},
+		{
+			name:    "mafia doesn't kill mafia",
+			actions: then(night, Kill{Killer: 3, Target: 3}),
+			wantErr: ErrWrongTarget,
+		},
+		{
+			name:    "commissar doesn't check self",
+			actions: then(night, Check{Checker: 2, Target: 2}),
+			wantErr: ErrWrongTarget,
+		},
+		{
+			name:    "act without target",
+			actions: then(night, Act{Kind: ActionKill, Actor: 3}),
+			wantErr: ErrTargetsCount,
+		},
+		{
+			name:    "civilian can't kill",
+			actions: then(night, Kill{Killer: 0, Target: 1}),
+			wantErr: ErrWrongRole,
+		},
+		{
+			name:    "kill dead player",
+			actions: then(night, Leave{Player: 1}, Kill{Killer: 3, Target: 1}),
+			wantErr: ErrTargetDead,
+		},
+		{
+			name:    "kill at day",
+			actions: []Action{Start{}, Kill{Killer: 3, Target: 0}},
+			wantErr: ErrWrongPhase,
+		},
+		{
+			name:    "unknown action",
+			actions: then(night, Act{Kind: "poison", Actor: 3, Targets: []int{0}}),
+			wantErr: ErrUnknownAction,
+		},
+	})
+}
+
+func TestApplyNightTeam(t *testing.T) {
+	roles := []string{RoleCivilian, RoleCivilian, RoleCivilian, RoleCommissar, RoleDon, RoleMafia, RoleCivilian}
+	night := then([]Action{Start{}}, skipVotes(0, 1, 2, 3, 4, 5, 6)...)
+	seek := func(target int) Action {
+		return Act{Kind: ActionSeek, Actor: 4, Targets: []int{target}}
+	}
+	dawn := PhaseChanged{Phase: PhaseDay, Day: 2}
+
+	runCases(t, roles, []applyCase{
+		{
+			name:    "don finds commissar",
+			actions: then(night, seek(3), Kill{Killer: 5, Target: 0}, Check{Checker: 3, Target: 1}),
+			want: []Event{
+				PlayerDied{Player: 0},
+				Investigated{Day: 1, Checker: 4, Target: 3, Role: RoleCommissar, Kind: ActionSeek},
+				Investigated{Day: 1, Checker: 3, Target: 1, Role: RoleCivilian, Kind: ActionCheck},
+				PlayerKilled{Player: 0},
+				dawn,
+			},
+			check: func(t *testing.T, s *State) {
+				if s.KnownRole(4, 3) != RoleCommissar || s.KnownRole(5, 3) != "" {
+					t.Fatal("only the don knows the found commissar")
+				}
+			},
+		},
+		{
+			name:    "don misses commissar",
+			actions: then(night, seek(1), Kill{Killer: 4, Target: 0}, Check{Checker: 3, Target: 5}),
+			want: []Event{
+				PlayerDied{Player: 0},
+				Investigated{Day: 1, Checker: 4, Target: 1, Kind: ActionSeek},
+				Investigated{Day: 1, Checker: 3, Target: 5, Role: RoleMafia, Kind: ActionCheck},
+				PlayerKilled{Player: 0},
+				dawn,
+			},
+		},
+		{
+			name:    "mafia kills once for the team",
+			actions: then(night, Kill{Killer: 4, Target: 0}, Kill{Killer: 5, Target: 1}),
+			wantErr: ErrAlreadyKilled,
+		},
+		{
+			name:    "don seeks once",
+			actions: then(night, seek(1), seek(2)),
+			wantErr: ErrAlreadyChecked,
+		},
+		{
+			name:    "don doesn't seek mafia",
+			actions: then(night, seek(5)),
+			wantErr: ErrWrongTarget,
+		},
+		{
+			name:    "mafia can't seek",
+			actions: then(night, Act{Kind: ActionSeek, Actor: 5, Targets: []int{3}}),
+			wantErr: ErrWrongRole,
+		},
+		{
+			name: "sought player isn't sought again",
+			actions: then(night, then([]Action{seek(1), Kill{Killer: 5, Target: 0}, Check{Checker: 3, Target: 2}},
+				then(skipVotes(1, 2, 3, 4, 5, 6), seek(1))...)...),
+			wantErr: ErrWrongTarget,
+		},
+	})
+}
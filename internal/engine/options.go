@@ -0,0 +1,110 @@
+package engine
+
+const (
+	ActionVote     string = "vote"
+	ActionNominate string = "nominate"
+	// Speaker ends last words, meeting or speech
+	ActionPass string = "pass"
+	// The first night victim names players they suspect
+	ActionBestMove string = "best_move"
+
+	BestMoveSize int = 3
+)
+
+// Option is an action player can take right now
+type Option struct {
+	Kind    string
+	Title   string
+	Targets []int
+	// Target may be SkipVote
+	CanSkip bool
+	// Action takes no target
+	NoTarget bool
+	// Action takes from one to MaxTargets targets, 0 means exactly one
+	MaxTargets   int
+	Confirmation string
+}
+
+// Options lists actions available to player pid, so clients don't need to know the rules
+func (s *State) Options(pid int) []Option {
+	res := make([]Option, 0)
+	if s.BestMoveOpen && s.BestMover == pid {
+		res = append(res, Option{Kind: ActionBestMove, Title: "action.best_move", Targets: s.aliveTargets(), MaxTargets: BestMoveSize})
+	}
+	if s.Speaking(pid) {
+		if s.Phase == PhaseMeeting {
+			return append(res, Option{Kind: ActionPass, Title: "action.end_meeting", NoTarget: true})
+		}
+		// speakers are already dead, they can only finish their last words
+		return append(res, Option{Kind: ActionPass, Title: "action.pass", NoTarget: true})
+	}
+	if !s.valid(pid) || !s.Players[pid].Alive {
+		return res
+	}
+
+	switch s.Phase {
+	case PhaseDay:
+		if s.Settings.Speeches {
+			if s.Speaker() != pid {
+				break
+			}
+			if candidates := s.candidates(pid); s.Nominations[pid] == NoVote && len(candidates) > 0 {
+				res = append(res, Option{Kind: ActionNominate, Title: "action.nominate", Targets: candidates})
+			}
+			res = append(res, Option{Kind: ActionPass, Title: "action.end_speech", NoTarget: true})
+		} else if s.Settings.Nominations {
+			if s.Nominations[pid] == NoVote {
+				res = append(res, Option{Kind: ActionNominate, Title: "action.nominate", Targets: s.candidates(pid), CanSkip: true})
+			}
+		} else if s.Votes[pid] == NoVote {
+			res = append(res, Option{Kind: ActionVote, Title: "action.vote", Targets: s.aliveTargets(), CanSkip: true})
+		}
+	case PhaseVoting:
+		if voter := s.Voter(); s.Votes[pid] == NoVote && (voter == -1 || voter == pid) {
+			res = append(res, Option{Kind: ActionVote, Title: "action.vote", Targets: append([]int(nil), s.Nominees...), CanSkip: true})
+		}
+	case PhaseNight:
+		for _, rule := range GetRole(s.Players[pid].Role).Night() {
+			if s.Acted(pid, rule.Kind) {
+				continue
+			}
+			if targets := s.nightTargets(pid, &rule); len(targets) > 0 {
+				res = append(res, Option{Kind: rule.Kind, Title: rule.Title, Targets: targets, Confirmation: rule.Confirmation})
+			}
+		}
+	}
+	return res
+}
+
+func (s *State) aliveTargets() []int {
+	res := make([]int, 0)
+	for i, p := range s.Players {
+		if p.Alive {
+			res = append(res, i)
+		}
+	}
+	return res
+}
+
+// candidates lists alive players whom player pid can nominate
+func (s *State) candidates(pid int) []int {
+	res := make([]int, 0)
+	for _, t := range s.aliveTargets() {
+		if t != pid && !s.Nominated(t) {
+			res = append(res, t)
+		}
+	}
+	return res
+}
+
+// nightTargets lists players who can be chosen for night action of player pid
+func (s *State) nightTargets(pid int, rule *NightRule) []int {
+	role := GetRole(s.Players[pid].Role)
+	res := make([]int, 0)
+	for _, t := range s.aliveTargets() {
+		if role.Validate(s, NightAction{Kind: rule.Kind, Actor: pid, Target: t}) == nil {
+			res = append(res, t)
+		}
+	}
+	return res
+}
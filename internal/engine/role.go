@@ -0,0 +1,301 @@
+package engine
+
+import (
+	"fmt"
+)
+
+// Team wins or loses together, its ID is the winner of the game.
+// Texts of teams, roles and actions are message keys, clients render them in their language.
+type Team struct {
+	ID    string
+	Title string
+}
+
+var (
+	TeamTown  = Team{ID: "civ", Title: "team.civ"}
+	TeamMafia = Team{ID: "maf", Title: "team.maf"}
+)
+
+// Role defines what player can do and when their team wins.
+// Adding a role means implementing Role and registering it with RegisterRole.
+type Role interface {
+	// ID is stored in state and sent to clients
+	ID() string
+	Title() string
+	Team() Team
+	// Color is a hint for clients: green, red, cyan, yellow, blue or magenta
+	Color() string
+	// Night actions of the role, nil if role sleeps at night
+	Night() []NightRule
+	// Shown to player at night
+	NightHint() string
+	// Validate checks role-specific rules of the night action, common ones are checked by engine
+	Validate(s *State, a NightAction) error
+	// Knows reports whether viewer of this role knows the role of player pid
+	Knows(s *State, viewer int, pid int) bool
+	// Won reports whether team of the role has won
+	Won(s *State) bool
+}
+
+var (
+	roles     = make(map[string]Role)
+	roleOrder = make([]string, 0)
+)
+
+// RegisterRole makes role available in games, call it from init
+func RegisterRole(r Role) {
+	if _, ok := roles[r.ID()]; !ok {
+		roleOrder = append(roleOrder, r.ID())
+	}
+	roles[r.ID()] = r
+	for _, rule := range r.Night() {
+		registerNightAction(r.ID(), rule)
+	}
+}
+
+// GetRole returns nil for unknown role
+func GetRole(id string) Role {
+	return roles[id]
+}
+
+// AllRoles returns registered roles in registration order
+func AllRoles() []Role {
+	res := make([]Role, 0)
+	for _, id := range roleOrder {
+		res = append(res, roles[id])
+	}
+	return res
+}
+
+func checkRoles(ids []string) error {
+	for _, id := range ids {
+		if GetRole(id) == nil {
+			return fmt.Errorf("unknown role %q", id)
+		}
+	}
+	return nil
+}
+
+func init() {
+	RegisterRole(civilian{})
+	RegisterRole(mafia{})
+	RegisterRole(commissar{})
+	RegisterRole(don{})
+}
+
+// TeamAlive counts alive players of the team
+func (s *State) TeamAlive(team Team) int {
+	res := 0
+	for _, p := range s.Players {
+		if p.Alive && GetRole(p.Role).Team() == team {
+			res += 1
+		}
+	}
+	return res
+}
+
+// town wins when all mafia is dead
+type town struct{}
+
+func (town) Team() Team {
+	return TeamTown
+}
+
+func (town) Won(s *State) bool {
+	return s.TeamAlive(TeamMafia) == 0
+}
+
+type civilian struct {
+	town
+}
+
+func (civilian) ID() string {
+	return RoleCivilian
+}
+
+func (civilian) Title() string {
+	return "role.civ"
+}
+
+func (civilian) Color() string {
+	return "green"
+}
+
+func (civilian) Night() []NightRule {
+	return nil
+}
+
+func (civilian) NightHint() string {
+	return "role.civ.hint"
+}
+
+func (civilian) Validate(s *State, a NightAction) error {
+	return ErrWrongRole
+}
+
+func (civilian) Knows(s *State, viewer int, pid int) bool {
+	return false
+}
+
+type commissar struct {
+	town
+}
+
+func (commissar) ID() string {
+	return RoleCommissar
+}
+
+func (commissar) Title() string {
+	return "role.com"
+}
+
+func (commissar) Color() string {
+	return "cyan"
+}
+
+func (commissar) Night() []NightRule {
+	return []NightRule{{
+		Kind:         ActionCheck,
+		Title:        "action.check",
+		Confirmation: "action.check.done",
+		Priority:     PriorityInvestigate,
+		Team:         true,
+		Repeated:     ErrAlreadyChecked,
+		Resolve:      ResolveInvestigate,
+	}}
+}
+
+func (commissar) NightHint() string {
+	return "role.com.hint"
+}
+
+// Commissar knows own role and doesn't check already checked players
+func (commissar) Validate(s *State, a NightAction) error {
+	if a.Target == a.Actor || (commissar{}).Knows(s, a.Actor, a.Target) {
+		return ErrWrongTarget
+	}
+	return nil
+}
+
+func (commissar) Knows(s *State, viewer int, pid int) bool {
+	for _, c := range s.Checks {
+		if c.Checked == pid && c.Action() == ActionCheck {
+			return true
+		}
+	}
+	return false
+}
+
+// mafia wins when it is not outnumbered
+type mafia struct{}
+
+func (mafia) ID() string {
+	return RoleMafia
+}
+
+func (mafia) Title() string {
+	return "role.maf"
+}
+
+func (mafia) Team() Team {
+	return TeamMafia
+}
+
+func (mafia) Color() string {
+	return "red"
+}
+
+func (mafia) Night() []NightRule {
+	return []NightRule{{
+		Kind:         ActionKill,
+		Title:        "action.kill",
+		Confirmation: "action.kill.done",
+		Priority:     PriorityKill,
+		Team:         true,
+		Repeated:     ErrAlreadyKilled,
+		Resolve:      ResolveKill,
+	}}
+}
+
+func (mafia) NightHint() string {
+	return "role.maf.hint"
+}
+
+// Mafia doesn't kill its own members
+func (mafia) Validate(s *State, a NightAction) error {
+	if GetRole(s.Players[a.Target].Role).Team() == TeamMafia {
+		return ErrWrongTarget
+	}
+	return nil
+}
+
+func (mafia) Knows(s *State, viewer int, pid int) bool {
+	return GetRole(s.Players[pid].Role).Team() == TeamMafia
+}
+
+func (mafia) Won(s *State) bool {
+	alive := s.TeamAlive(TeamMafia)
+	return alive > 0 && alive >= s.AliveCount()-alive
+}
+
+// don leads the mafia: kills together with it and seeks the commissar
+type don struct {
+	mafia
+}
+
+func (don) ID() string {
+	return RoleDon
+}
+
+func (don) Title() string {
+	return "role.don"
+}
+
+func (don) Color() string {
+	return "magenta"
+}
+
+func (don) Night() []NightRule {
+	return append(mafia{}.Night(), NightRule{
+		Kind:         ActionSeek,
+		Title:        "action.seek",
+		Confirmation: "action.seek.done",
+		Priority:     PriorityInvestigate,
+		Team:         true,
+		Repeated:     ErrAlreadyChecked,
+		Resolve:      ResolveInvestigate,
+	})
+}
+
+func (don) NightHint() string {
+	return "role.don.hint"
+}
+
+// Don doesn't seek among mafia and among already sought players
+func (don) Validate(s *State, a NightAction) error {
+	if a.Kind != ActionSeek {
+		return mafia{}.Validate(s, a)
+	}
+	if GetRole(s.Players[a.Target].Role).Team() == TeamMafia {
+		return ErrWrongTarget
+	}
+	for _, c := range s.Checks {
+		if c.Checked == a.Target && c.Action() == ActionSeek {
+			return ErrWrongTarget
+		}
+	}
+	return nil
+}
+
+// Don knows mafia and the commissar once found
+func (don) Knows(s *State, viewer int, pid int) bool {
+	if (mafia{}).Knows(s, viewer, pid) {
+		return true
+	}
+	for _, c := range s.Checks {
+		if c.Checked == pid && c.Action() == ActionSeek && s.CheckedRole(c) != "" {
+			return true
+		}
+	}
+	return false
+}
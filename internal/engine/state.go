@@ -0,0 +1,331 @@
+package engine
+
+import (
+	"errors"
+)
+
+type Phase int
+
+const (
+	PhaseNotStarted Phase = iota
+	PhaseDay
+	PhaseNight
+	PhaseEnded
+	// Condemned players say last words, then the game goes on
+	PhaseLastWords
+	// Players vote for nominees, it follows the nomination stage of the day
+	PhaseVoting
+	// Mafia meets before the first day, nobody is killed
+	PhaseMeeting
+)
+
+func (p Phase) String() string {
+	switch p {
+	case PhaseNotStarted:
+		return "not started"
+	case PhaseDay:
+		return "day"
+	case PhaseNight:
+		return "night"
+	case PhaseEnded:
+		return "ended"
+	case PhaseLastWords:
+		return "last words"
+	case PhaseVoting:
+		return "voting"
+	case PhaseMeeting:
+		return "meeting"
+	}
+	return "unknown"
+}
+
+// IDs of classic roles
+const (
+	RoleCivilian  string = "civ"
+	RoleMafia     string = "maf"
+	RoleCommissar string = "com"
+	RoleDon       string = "don"
+
+	WinnerNone string = "none"
+
+	// Votes values besides pid of suspect
+	SkipVote int = -1
+	NoVote   int = -2
+)
+
+type Player struct {
+	Name  string `json:"name"`
+	Role  string `json:"role"`
+	Alive bool   `json:"alive"`
+}
+
+// Settings are optional rules, they are chosen before the game starts
+type Settings struct {
+	// Jailed player has last words before the night
+	LastWords bool `json:"last_words"`
+	// Night victims have last words at dawn
+	NightLastWords bool `json:"night_last_words"`
+	// Day starts with nominations, then players vote for nominees only
+	Nominations bool `json:"nominations"`
+	// Nominees are voted for in seat order instead of all at once
+	SeatOrderVoting bool `json:"seat_order_voting"`
+	// Day starts with speeches in seat order, players nominate in their own speech only.
+	// Requires Nominations.
+	Speeches bool `json:"speeches"`
+	// Player is removed from the game after this many fouls, 0 disables fouls
+	FoulLimit int `json:"foul_limit"`
+	// Game starts with the meeting of mafia instead of the first day
+	Meeting bool `json:"meeting"`
+	// The first night victim names players they suspect, guess is scored at the end
+	BestMove bool `json:"best_move"`
+}
+
+// SportSettings are rules of competitive games
+func SportSettings() Settings {
+	return Settings{
+		Nominations: true,
+		Speeches:    true,
+		FoulLimit:   4,
+		Meeting:     true,
+		BestMove:    true,
+	}
+}
+
+type CheckRecord struct {
+	Day     int `json:"day"`
+	Checked int `json:"checked"`
+	// Night action kind, empty for checks saved before other kinds were added
+	Kind string `json:"kind,omitempty"`
+}
+
+// State is the whole game, it is changed only by Apply
+type State struct {
+	Players []Player `json:"players"`
+	Phase   Phase    `json:"phase"`
+	// Day number starting from 1, night belongs to the day before it
+	Day int `json:"day"`
+
+	Votes      []int `json:"votes"`
+	VotesCount []int `json:"votes_count"`
+	// Nominations[pid] is pid nominated by the player, values are the same as in Votes
+	Nominations []int `json:"nominations,omitempty"`
+	Nominees    []int `json:"nominees,omitempty"`
+	// Players who have not spoken yet this day, the first one is speaking now
+	Queue []int `json:"queue,omitempty"`
+	Fouls []int `json:"fouls,omitempty"`
+
+	// Actions chosen this night, they are resolved at dawn
+	Night  []NightAction `json:"night"`
+	Checks []CheckRecord `json:"checks"`
+
+	Settings Settings `json:"settings"`
+	// Players having last words and the phase which starts after them
+	Speakers  []int `json:"speakers,omitempty"`
+	NextPhase Phase `json:"next_phase,omitempty"`
+
+	// The first night victim may make the best move until the next night
+	BestMover    int   `json:"best_mover"`
+	BestMove     []int `json:"best_move,omitempty"`
+	BestMoveOpen bool  `json:"best_move_open,omitempty"`
+
+	Winner string `json:"winner,omitempty"`
+}
+
+// NewGame creates not started game, roles[i] is the role of names[i]
+func NewGame(names []string, roles []string) (*State, error) {
+	if len(names) != len(roles) {
+		return nil, errors.New("roles count doesn't match players count")
+	}
+	if err := checkRoles(roles); err != nil {
+		return nil, err
+	}
+
+	s := &State{
+		Players:     make([]Player, len(names)),
+		Phase:       PhaseNotStarted,
+		Votes:       make([]int, len(names)),
+		VotesCount:  make([]int, len(names)),
+		Nominations: make([]int, len(names)),
+		Fouls:       make([]int, len(names)),
+		BestMover:   -1,
+	}
+	for i := range names {
+		s.Players[i] = Player{Name: names[i], Role: roles[i], Alive: true}
+		s.Votes[i] = NoVote
+		s.Nominations[i] = NoVote
+	}
+	return s, nil
+}
+
+func (s *State) Clone() *State {
+	c := *s
+	c.Players = append([]Player(nil), s.Players...)
+	c.Votes = append([]int(nil), s.Votes...)
+	c.VotesCount = append([]int(nil), s.VotesCount...)
+	c.Nominations = append([]int(nil), s.Nominations...)
+	c.Nominees = append([]int(nil), s.Nominees...)
+	c.Night = append([]NightAction(nil), s.Night...)
+	c.Checks = append([]CheckRecord(nil), s.Checks...)
+	c.Speakers = append([]int(nil), s.Speakers...)
+	c.Queue = append([]int(nil), s.Queue...)
+	c.Fouls = append([]int(nil), s.Fouls...)
+	c.BestMove = append([]int(nil), s.BestMove...)
+	return &c
+}
+
+func (s *State) AliveCount() int {
+	res := 0
+	for _, p := range s.Players {
+		if p.Alive {
+			res += 1
+		}
+	}
+	return res
+}
+
+func (s *State) Names() []string {
+	names := make([]string, 0)
+	for _, p := range s.Players {
+		names = append(names, p.Name)
+	}
+	return names
+}
+
+func (s *State) Roles() []string {
+	roles := make([]string, 0)
+	for _, p := range s.Players {
+		roles = append(roles, p.Role)
+	}
+	return roles
+}
+
+// Find returns pid of player with the name, -1 if there is no such player
+func (s *State) Find(name string) int {
+	for i, p := range s.Players {
+		if p.Name == name {
+			return i
+		}
+	}
+	return -1
+}
+
+// Speaking reports whether player pid has last words or takes part in the meeting now
+func (s *State) Speaking(pid int) bool {
+	if s.Phase != PhaseLastWords && s.Phase != PhaseMeeting {
+		return false
+	}
+	return contains(s.Speakers, pid)
+}
+
+// Speaker returns pid of player giving a speech now, -1 if there are no speeches
+func (s *State) Speaker() int {
+	if s.Phase != PhaseDay || !s.Settings.Speeches || len(s.Queue) == 0 {
+		return -1
+	}
+	return s.Queue[0]
+}
+
+// Silenced reports whether player pid may not talk now, because somebody else has the floor
+func (s *State) Silenced(pid int) bool {
+	switch s.Phase {
+	case PhaseLastWords, PhaseMeeting:
+		return !s.Speaking(pid)
+	case PhaseDay:
+		return s.Settings.Speeches && s.Speaker() != pid
+	}
+	return false
+}
+
+// Nominated reports whether player pid is put up for the vote
+func (s *State) Nominated(pid int) bool {
+	return contains(s.Nominees, pid)
+}
+
+// Voter returns pid of player voting now if votes are cast in seat order, -1 otherwise
+func (s *State) Voter() int {
+	if s.Phase != PhaseVoting || !s.Settings.SeatOrderVoting {
+		return -1
+	}
+	for i, p := range s.Players {
+		if p.Alive && s.Votes[i] == NoVote {
+			return i
+		}
+	}
+	return -1
+}
+
+func contains(pids []int, pid int) bool {
+	for _, p := range pids {
+		if p == pid {
+			return true
+		}
+	}
+	return false
+}
+
+func without(pids []int, pid int) []int {
+	kept := make([]int, 0)
+	for _, p := range pids {
+		if p != pid {
+			kept = append(kept, p)
+		}
+	}
+	return kept
+}
+
+func (s *State) valid(pid int) bool {
+	return pid >= 0 && pid < len(s.Players)
+}
+
+// winner returns empty string if game goes on
+func (s *State) winner() string {
+	for _, id := range roleOrder {
+		r := roles[id]
+		if r.Won(s) {
+			return r.Team().ID
+		}
+	}
+	return ""
+}
+
+// KnownRole returns role of player pid as it is known to player viewer, empty if it is secret
+func (s *State) KnownRole(viewer int, pid int) string {
+	role := s.Players[pid].Role
+	if s.Phase == PhaseEnded || viewer == pid {
+		return role
+	}
+	if GetRole(s.Players[viewer].Role).Knows(s, viewer, pid) {
+		return role
+	}
+	return ""
+}
+
+// Investigations returns checks known to viewer, they are shared by players having the same action
+func (s *State) Investigations(viewer int) []CheckRecord {
+	var res []CheckRecord
+	for _, rule := range GetRole(s.Players[viewer].Role).Night() {
+		for _, c := range s.Checks {
+			if c.Action() == rule.Kind {
+				res = append(res, c)
+			}
+		}
+	}
+	return res
+}
+
+func (c CheckRecord) Action() string {
+	if c.Kind == "" {
+		return ActionCheck
+	}
+	return c.Kind
+}
+
+// CheckedRole is the result of the check as it is known to the checker.
+// Seeking tells only whether the player is the commissar.
+func (s *State) CheckedRole(c CheckRecord) string {
+	role := s.Players[c.Checked].Role
+	if c.Action() == ActionSeek && role != RoleCommissar {
+		return ""
+	}
+	return role
+}
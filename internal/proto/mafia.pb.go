@@ -9,6 +9,7 @@ package proto
 import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 )
@@ -20,6 +21,335 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type ArgType int32
+
+const (
+	ArgType_ARG_TYPE_NONE ArgType = 0
+	// pid of a player
+	ArgType_ARG_TYPE_PLAYER ArgType = 1
+)
+
+// Enum value maps for ArgType.
+var (
+	ArgType_name = map[int32]string{
+		0: "ARG_TYPE_NONE",
+		1: "ARG_TYPE_PLAYER",
+	}
+	ArgType_value = map[string]int32{
+		"ARG_TYPE_NONE":   0,
+		"ARG_TYPE_PLAYER": 1,
+	}
+)
+
+func (x ArgType) Enum() *ArgType {
+	p := new(ArgType)
+	*p = x
+	return p
+}
+
+func (x ArgType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ArgType) Descriptor() protoreflect.EnumDescriptor {
+	return file_mafia_proto_enumTypes[0].Descriptor()
+}
+
+func (ArgType) Type() protoreflect.EnumType {
+	return &file_mafia_proto_enumTypes[0]
+}
+
+func (x ArgType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ArgType.Descriptor instead.
+func (ArgType) EnumDescriptor() ([]byte, []int) {
+	return file_mafia_proto_rawDescGZIP(), []int{0}
+}
+
+type ActionOutcome int32
+
+const (
+	ActionOutcome_ACTION_OUTCOME_UNSPECIFIED ActionOutcome = 0
+	// Action took effect immediately, e.g. vote
+	ActionOutcome_ACTION_OUTCOME_APPLIED ActionOutcome = 1
+	// Night action, it is resolved at dawn together with others
+	ActionOutcome_ACTION_OUTCOME_SCHEDULED ActionOutcome = 2
+)
+
+// Enum value maps for ActionOutcome.
+var (
+	ActionOutcome_name = map[int32]string{
+		0: "ACTION_OUTCOME_UNSPECIFIED",
+		1: "ACTION_OUTCOME_APPLIED",
+		2: "ACTION_OUTCOME_SCHEDULED",
+	}
+	ActionOutcome_value = map[string]int32{
+		"ACTION_OUTCOME_UNSPECIFIED": 0,
+		"ACTION_OUTCOME_APPLIED":     1,
+		"ACTION_OUTCOME_SCHEDULED":   2,
+	}
+)
+
+func (x ActionOutcome) Enum() *ActionOutcome {
+	p := new(ActionOutcome)
+	*p = x
+	return p
+}
+
+func (x ActionOutcome) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ActionOutcome) Descriptor() protoreflect.EnumDescriptor {
+	return file_mafia_proto_enumTypes[1].Descriptor()
+}
+
+func (ActionOutcome) Type() protoreflect.EnumType {
+	return &file_mafia_proto_enumTypes[1]
+}
+
+func (x ActionOutcome) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ActionOutcome.Descriptor instead.
+func (ActionOutcome) EnumDescriptor() ([]byte, []int) {
+	return file_mafia_proto_rawDescGZIP(), []int{1}
+}
+
+// Reason of a failed RPC. It is sent in google.rpc.ErrorInfo details
+// with domain "mafia", so clients can render errors themselves.
+type ErrorReason int32
+
+const (
+	ErrorReason_ERROR_REASON_UNSPECIFIED ErrorReason = 0
+	// Required field is missing or malformed
+	ErrorReason_ERROR_REASON_BAD_REQUEST    ErrorReason = 1
+	ErrorReason_ERROR_REASON_UNKNOWN_PLAYER ErrorReason = 2
+	ErrorReason_ERROR_REASON_DEAD_PLAYER    ErrorReason = 3
+	ErrorReason_ERROR_REASON_WRONG_ROLE     ErrorReason = 4
+	ErrorReason_ERROR_REASON_WRONG_PHASE    ErrorReason = 5
+	ErrorReason_ERROR_REASON_UNKNOWN_ACTION ErrorReason = 6
+	ErrorReason_ERROR_REASON_WRONG_TARGET   ErrorReason = 7
+	ErrorReason_ERROR_REASON_TARGET_DEAD    ErrorReason = 8
+	ErrorReason_ERROR_REASON_ALREADY_ACTED  ErrorReason = 9
+	ErrorReason_ERROR_REASON_GAME_PAUSED    ErrorReason = 10
+	ErrorReason_ERROR_REASON_GAME_OVER      ErrorReason = 11
+	ErrorReason_ERROR_REASON_NAME_TAKEN     ErrorReason = 12
+	ErrorReason_ERROR_REASON_BANNED         ErrorReason = 13
+	ErrorReason_ERROR_REASON_SHUTTING_DOWN  ErrorReason = 14
+	ErrorReason_ERROR_REASON_TOO_SLOW       ErrorReason = 15
+	ErrorReason_ERROR_REASON_NOT_YOUR_TURN  ErrorReason = 16
+	ErrorReason_ERROR_REASON_UNKNOWN_GAME   ErrorReason = 17
+	ErrorReason_ERROR_REASON_BAD_TOKEN      ErrorReason = 18
+)
+
+// Enum value maps for ErrorReason.
+var (
+	ErrorReason_name = map[int32]string{
+		0:  "ERROR_REASON_UNSPECIFIED",
+		1:  "ERROR_REASON_BAD_REQUEST",
+		2:  "ERROR_REASON_UNKNOWN_PLAYER",
+		3:  "ERROR_REASON_DEAD_PLAYER",
+		4:  "ERROR_REASON_WRONG_ROLE",
+		5:  "ERROR_REASON_WRONG_PHASE",
+		6:  "ERROR_REASON_UNKNOWN_ACTION",
+		7:  "ERROR_REASON_WRONG_TARGET",
+		8:  "ERROR_REASON_TARGET_DEAD",
+		9:  "ERROR_REASON_ALREADY_ACTED",
+		10: "ERROR_REASON_GAME_PAUSED",
+		11: "ERROR_REASON_GAME_OVER",
+		12: "ERROR_REASON_NAME_TAKEN",
+		13: "ERROR_REASON_BANNED",
+		14: "ERROR_REASON_SHUTTING_DOWN",
+		15: "ERROR_REASON_TOO_SLOW",
+		16: "ERROR_REASON_NOT_YOUR_TURN",
+		17: "ERROR_REASON_UNKNOWN_GAME",
+		18: "ERROR_REASON_BAD_TOKEN",
+	}
+	ErrorReason_value = map[string]int32{
+		"ERROR_REASON_UNSPECIFIED":    0,
+		"ERROR_REASON_BAD_REQUEST":    1,
+		"ERROR_REASON_UNKNOWN_PLAYER": 2,
+		"ERROR_REASON_DEAD_PLAYER":    3,
+		"ERROR_REASON_WRONG_ROLE":     4,
+		"ERROR_REASON_WRONG_PHASE":    5,
+		"ERROR_REASON_UNKNOWN_ACTION": 6,
+		"ERROR_REASON_WRONG_TARGET":   7,
+		"ERROR_REASON_TARGET_DEAD":    8,
+		"ERROR_REASON_ALREADY_ACTED":  9,
+		"ERROR_REASON_GAME_PAUSED":    10,
+		"ERROR_REASON_GAME_OVER":      11,
+		"ERROR_REASON_NAME_TAKEN":     12,
+		"ERROR_REASON_BANNED":         13,
+		"ERROR_REASON_SHUTTING_DOWN":  14,
+		"ERROR_REASON_TOO_SLOW":       15,
+		"ERROR_REASON_NOT_YOUR_TURN":  16,
+		"ERROR_REASON_UNKNOWN_GAME":   17,
+		"ERROR_REASON_BAD_TOKEN":      18,
+	}
+)
+
+func (x ErrorReason) Enum() *ErrorReason {
+	p := new(ErrorReason)
+	*p = x
+	return p
+}
+
+func (x ErrorReason) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ErrorReason) Descriptor() protoreflect.EnumDescriptor {
+	return file_mafia_proto_enumTypes[2].Descriptor()
+}
+
+func (ErrorReason) Type() protoreflect.EnumType {
+	return &file_mafia_proto_enumTypes[2]
+}
+
+func (x ErrorReason) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ErrorReason.Descriptor instead.
+func (ErrorReason) EnumDescriptor() ([]byte, []int) {
+	return file_mafia_proto_rawDescGZIP(), []int{2}
+}
+
+type EventType int32
+
+const (
+	EventType_EVENT_TYPE_UNSPECIFIED     EventType = 0
+	EventType_EVENT_TYPE_PHASE_CHANGED   EventType = 1
+	EventType_EVENT_TYPE_PLAYER_KILLED   EventType = 2
+	EventType_EVENT_TYPE_PLAYER_JAILED   EventType = 3
+	EventType_EVENT_TYPE_GAME_END        EventType = 4
+	EventType_EVENT_TYPE_YOU_DEAD        EventType = 5
+	EventType_EVENT_TYPE_SERVER_SHUTDOWN EventType = 6
+	EventType_EVENT_TYPE_CHECK_RESULT    EventType = 7
+	EventType_EVENT_TYPE_VOTE_TURN       EventType = 8
+	EventType_EVENT_TYPE_SPEECH_TURN     EventType = 9
+)
+
+// Enum value maps for EventType.
+var (
+	EventType_name = map[int32]string{
+		0: "EVENT_TYPE_UNSPECIFIED",
+		1: "EVENT_TYPE_PHASE_CHANGED",
+		2: "EVENT_TYPE_PLAYER_KILLED",
+		3: "EVENT_TYPE_PLAYER_JAILED",
+		4: "EVENT_TYPE_GAME_END",
+		5: "EVENT_TYPE_YOU_DEAD",
+		6: "EVENT_TYPE_SERVER_SHUTDOWN",
+		7: "EVENT_TYPE_CHECK_RESULT",
+		8: "EVENT_TYPE_VOTE_TURN",
+		9: "EVENT_TYPE_SPEECH_TURN",
+	}
+	EventType_value = map[string]int32{
+		"EVENT_TYPE_UNSPECIFIED":     0,
+		"EVENT_TYPE_PHASE_CHANGED":   1,
+		"EVENT_TYPE_PLAYER_KILLED":   2,
+		"EVENT_TYPE_PLAYER_JAILED":   3,
+		"EVENT_TYPE_GAME_END":        4,
+		"EVENT_TYPE_YOU_DEAD":        5,
+		"EVENT_TYPE_SERVER_SHUTDOWN": 6,
+		"EVENT_TYPE_CHECK_RESULT":    7,
+		"EVENT_TYPE_VOTE_TURN":       8,
+		"EVENT_TYPE_SPEECH_TURN":     9,
+	}
+)
+
+func (x EventType) Enum() *EventType {
+	p := new(EventType)
+	*p = x
+	return p
+}
+
+func (x EventType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (EventType) Descriptor() protoreflect.EnumDescriptor {
+	return file_mafia_proto_enumTypes[3].Descriptor()
+}
+
+func (EventType) Type() protoreflect.EnumType {
+	return &file_mafia_proto_enumTypes[3]
+}
+
+func (x EventType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use EventType.Descriptor instead.
+func (EventType) EnumDescriptor() ([]byte, []int) {
+	return file_mafia_proto_rawDescGZIP(), []int{3}
+}
+
+type Phase int32
+
+const (
+	Phase_PHASE_UNSPECIFIED Phase = 0
+	Phase_PHASE_DAY         Phase = 1
+	Phase_PHASE_NIGHT       Phase = 2
+	// Players vote for nominees, it follows the nomination stage of PHASE_DAY
+	Phase_PHASE_VOTING Phase = 3
+	// Condemned players say last words, only they can chat
+	Phase_PHASE_LAST_WORDS Phase = 4
+	// Mafia gets acquainted before the first day, only mafia can chat
+	Phase_PHASE_MEETING Phase = 5
+)
+
+// Enum value maps for Phase.
+var (
+	Phase_name = map[int32]string{
+		0: "PHASE_UNSPECIFIED",
+		1: "PHASE_DAY",
+		2: "PHASE_NIGHT",
+		3: "PHASE_VOTING",
+		4: "PHASE_LAST_WORDS",
+		5: "PHASE_MEETING",
+	}
+	Phase_value = map[string]int32{
+		"PHASE_UNSPECIFIED": 0,
+		"PHASE_DAY":         1,
+		"PHASE_NIGHT":       2,
+		"PHASE_VOTING":      3,
+		"PHASE_LAST_WORDS":  4,
+		"PHASE_MEETING":     5,
+	}
+)
+
+func (x Phase) Enum() *Phase {
+	p := new(Phase)
+	*p = x
+	return p
+}
+
+func (x Phase) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Phase) Descriptor() protoreflect.EnumDescriptor {
+	return file_mafia_proto_enumTypes[4].Descriptor()
+}
+
+func (Phase) Type() protoreflect.EnumType {
+	return &file_mafia_proto_enumTypes[4]
+}
+
+func (x Phase) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Phase.Descriptor instead.
+func (Phase) EnumDescriptor() ([]byte, []int) {
+	return file_mafia_proto_rawDescGZIP(), []int{4}
+}
+
 type Player struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -417,6 +747,8 @@ type SubscribeToGameResponse struct {
 	unknownFields protoimpl.UnknownFields
 
 	GameAddr string `protobuf:"bytes,1,opt,name=GameAddr,proto3" json:"GameAddr,omitempty"`
+	// Same id as in Admin API
+	GameId string `protobuf:"bytes,2,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
 }
 
 func (x *SubscribeToGameResponse) Reset() {
@@ -458,6 +790,13 @@ func (x *SubscribeToGameResponse) GetGameAddr() string {
 	return ""
 }
 
+func (x *SubscribeToGameResponse) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
 type RoleRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -510,7 +849,8 @@ type RoleResponse struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Role string `protobuf:"bytes,1,opt,name=role,proto3" json:"role,omitempty"`
+	Role string    `protobuf:"bytes,1,opt,name=role,proto3" json:"role,omitempty"`
+	Info *RoleInfo `protobuf:"bytes,2,opt,name=info,proto3" json:"info,omitempty"`
 }
 
 func (x *RoleResponse) Reset() {
@@ -552,17 +892,36 @@ func (x *RoleResponse) GetRole() string {
 	return ""
 }
 
-type VoteRequest struct {
+func (x *RoleResponse) GetInfo() *RoleInfo {
+	if x != nil {
+		return x.Info
+	}
+	return nil
+}
+
+// Everything client needs to render a role, so new roles don't need client changes.
+// Titles and hints here and in ActionInfo are message keys, clients look them up
+// in their catalog and show unknown keys as is.
+type RoleInfo struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Player *Player `protobuf:"bytes,1,opt,name=player,proto3" json:"player,omitempty"`
-	Voting int32   `protobuf:"varint,2,opt,name=voting,proto3" json:"voting,omitempty"`
-}
-
-func (x *VoteRequest) Reset() {
-	*x = VoteRequest{}
+	Id        string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title     string `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Team      string `protobuf:"bytes,3,opt,name=team,proto3" json:"team,omitempty"`
+	TeamTitle string `protobuf:"bytes,4,opt,name=team_title,json=teamTitle,proto3" json:"team_title,omitempty"`
+	// green, red, cyan, yellow, blue or magenta
+	Color string `protobuf:"bytes,5,opt,name=color,proto3" json:"color,omitempty"`
+	// Kind of night action, empty if role sleeps at night
+	NightAction string `protobuf:"bytes,6,opt,name=night_action,json=nightAction,proto3" json:"night_action,omitempty"`
+	NightHint   string `protobuf:"bytes,7,opt,name=night_hint,json=nightHint,proto3" json:"night_hint,omitempty"`
+	// All night action kinds, role may have more than one
+	NightActions []string `protobuf:"bytes,8,rep,name=night_actions,json=nightActions,proto3" json:"night_actions,omitempty"`
+}
+
+func (x *RoleInfo) Reset() {
+	*x = RoleInfo{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_mafia_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -570,13 +929,13 @@ func (x *VoteRequest) Reset() {
 	}
 }
 
-func (x *VoteRequest) String() string {
+func (x *RoleInfo) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*VoteRequest) ProtoMessage() {}
+func (*RoleInfo) ProtoMessage() {}
 
-func (x *VoteRequest) ProtoReflect() protoreflect.Message {
+func (x *RoleInfo) ProtoReflect() protoreflect.Message {
 	mi := &file_mafia_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -588,36 +947,77 @@ func (x *VoteRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use VoteRequest.ProtoReflect.Descriptor instead.
-func (*VoteRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use RoleInfo.ProtoReflect.Descriptor instead.
+func (*RoleInfo) Descriptor() ([]byte, []int) {
 	return file_mafia_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *VoteRequest) GetPlayer() *Player {
+func (x *RoleInfo) GetId() string {
 	if x != nil {
-		return x.Player
+		return x.Id
 	}
-	return nil
+	return ""
 }
 
-func (x *VoteRequest) GetVoting() int32 {
+func (x *RoleInfo) GetTitle() string {
 	if x != nil {
-		return x.Voting
+		return x.Title
 	}
-	return 0
+	return ""
 }
 
-type KillRequest struct {
+func (x *RoleInfo) GetTeam() string {
+	if x != nil {
+		return x.Team
+	}
+	return ""
+}
+
+func (x *RoleInfo) GetTeamTitle() string {
+	if x != nil {
+		return x.TeamTitle
+	}
+	return ""
+}
+
+func (x *RoleInfo) GetColor() string {
+	if x != nil {
+		return x.Color
+	}
+	return ""
+}
+
+func (x *RoleInfo) GetNightAction() string {
+	if x != nil {
+		return x.NightAction
+	}
+	return ""
+}
+
+func (x *RoleInfo) GetNightHint() string {
+	if x != nil {
+		return x.NightHint
+	}
+	return ""
+}
+
+func (x *RoleInfo) GetNightActions() []string {
+	if x != nil {
+		return x.NightActions
+	}
+	return nil
+}
+
+type RoleListResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Player  *Player `protobuf:"bytes,1,opt,name=player,proto3" json:"player,omitempty"`
-	Killing int32   `protobuf:"varint,2,opt,name=killing,proto3" json:"killing,omitempty"`
+	Roles []*RoleInfo `protobuf:"bytes,1,rep,name=roles,proto3" json:"roles,omitempty"`
 }
 
-func (x *KillRequest) Reset() {
-	*x = KillRequest{}
+func (x *RoleListResponse) Reset() {
+	*x = RoleListResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_mafia_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -625,13 +1025,13 @@ func (x *KillRequest) Reset() {
 	}
 }
 
-func (x *KillRequest) String() string {
+func (x *RoleListResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*KillRequest) ProtoMessage() {}
+func (*RoleListResponse) ProtoMessage() {}
 
-func (x *KillRequest) ProtoReflect() protoreflect.Message {
+func (x *RoleListResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_mafia_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -643,36 +1043,40 @@ func (x *KillRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use KillRequest.ProtoReflect.Descriptor instead.
-func (*KillRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use RoleListResponse.ProtoReflect.Descriptor instead.
+func (*RoleListResponse) Descriptor() ([]byte, []int) {
 	return file_mafia_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *KillRequest) GetPlayer() *Player {
+func (x *RoleListResponse) GetRoles() []*RoleInfo {
 	if x != nil {
-		return x.Player
+		return x.Roles
 	}
 	return nil
 }
 
-func (x *KillRequest) GetKilling() int32 {
-	if x != nil {
-		return x.Killing
-	}
-	return 0
-}
-
-type CheckRequest struct {
+// Action the player can take right now, clients build their commands from it
+type ActionInfo struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Player   *Player `protobuf:"bytes,1,opt,name=player,proto3" json:"player,omitempty"`
-	Checking int32   `protobuf:"varint,2,opt,name=checking,proto3" json:"checking,omitempty"`
-}
-
-func (x *CheckRequest) Reset() {
-	*x = CheckRequest{}
+	// vote, kill, check, ...
+	Id    string  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title string  `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Arg   ArgType `protobuf:"varint,3,opt,name=arg,proto3,enum=mafiapb.ArgType" json:"arg,omitempty"`
+	// Valid pids for ARG_TYPE_PLAYER
+	Targets []int32 `protobuf:"varint,4,rep,packed,name=targets,proto3" json:"targets,omitempty"`
+	// Action may be skipped with pid -1
+	CanSkip bool `protobuf:"varint,5,opt,name=can_skip,json=canSkip,proto3" json:"can_skip,omitempty"`
+	// Message key shown to the player when action is accepted
+	Confirmation string `protobuf:"bytes,6,opt,name=confirmation,proto3" json:"confirmation,omitempty"`
+	// Action takes from one to max_targets pids, 0 means exactly one
+	MaxTargets int32 `protobuf:"varint,7,opt,name=max_targets,json=maxTargets,proto3" json:"max_targets,omitempty"`
+}
+
+func (x *ActionInfo) Reset() {
+	*x = ActionInfo{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_mafia_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -680,13 +1084,13 @@ func (x *CheckRequest) Reset() {
 	}
 }
 
-func (x *CheckRequest) String() string {
+func (x *ActionInfo) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CheckRequest) ProtoMessage() {}
+func (*ActionInfo) ProtoMessage() {}
 
-func (x *CheckRequest) ProtoReflect() protoreflect.Message {
+func (x *ActionInfo) ProtoReflect() protoreflect.Message {
 	mi := &file_mafia_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -698,35 +1102,73 @@ func (x *CheckRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CheckRequest.ProtoReflect.Descriptor instead.
-func (*CheckRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ActionInfo.ProtoReflect.Descriptor instead.
+func (*ActionInfo) Descriptor() ([]byte, []int) {
 	return file_mafia_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *CheckRequest) GetPlayer() *Player {
+func (x *ActionInfo) GetId() string {
 	if x != nil {
-		return x.Player
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ActionInfo) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *ActionInfo) GetArg() ArgType {
+	if x != nil {
+		return x.Arg
+	}
+	return ArgType_ARG_TYPE_NONE
+}
+
+func (x *ActionInfo) GetTargets() []int32 {
+	if x != nil {
+		return x.Targets
 	}
 	return nil
 }
 
-func (x *CheckRequest) GetChecking() int32 {
+func (x *ActionInfo) GetCanSkip() bool {
 	if x != nil {
-		return x.Checking
+		return x.CanSkip
+	}
+	return false
+}
+
+func (x *ActionInfo) GetConfirmation() string {
+	if x != nil {
+		return x.Confirmation
+	}
+	return ""
+}
+
+func (x *ActionInfo) GetMaxTargets() int32 {
+	if x != nil {
+		return x.MaxTargets
 	}
 	return 0
 }
 
-type CheckResponse struct {
+type PerformActionRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Role string `protobuf:"bytes,1,opt,name=role,proto3" json:"role,omitempty"`
+	Player *Player `protobuf:"bytes,1,opt,name=player,proto3" json:"player,omitempty"`
+	// One of ActionInfo.id
+	ActionId string  `protobuf:"bytes,2,opt,name=action_id,json=actionId,proto3" json:"action_id,omitempty"`
+	Targets  []int32 `protobuf:"varint,3,rep,packed,name=targets,proto3" json:"targets,omitempty"`
 }
 
-func (x *CheckResponse) Reset() {
-	*x = CheckResponse{}
+func (x *PerformActionRequest) Reset() {
+	*x = PerformActionRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_mafia_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -734,13 +1176,13 @@ func (x *CheckResponse) Reset() {
 	}
 }
 
-func (x *CheckResponse) String() string {
+func (x *PerformActionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CheckResponse) ProtoMessage() {}
+func (*PerformActionRequest) ProtoMessage() {}
 
-func (x *CheckResponse) ProtoReflect() protoreflect.Message {
+func (x *PerformActionRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_mafia_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -752,26 +1194,47 @@ func (x *CheckResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CheckResponse.ProtoReflect.Descriptor instead.
-func (*CheckResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use PerformActionRequest.ProtoReflect.Descriptor instead.
+func (*PerformActionRequest) Descriptor() ([]byte, []int) {
 	return file_mafia_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *CheckResponse) GetRole() string {
+func (x *PerformActionRequest) GetPlayer() *Player {
 	if x != nil {
-		return x.Role
+		return x.Player
+	}
+	return nil
+}
+
+func (x *PerformActionRequest) GetActionId() string {
+	if x != nil {
+		return x.ActionId
 	}
 	return ""
 }
 
-type DayChange struct {
+func (x *PerformActionRequest) GetTargets() []int32 {
+	if x != nil {
+		return x.Targets
+	}
+	return nil
+}
+
+type PerformActionResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	ActionId string        `protobuf:"bytes,1,opt,name=action_id,json=actionId,proto3" json:"action_id,omitempty"`
+	Outcome  ActionOutcome `protobuf:"varint,2,opt,name=outcome,proto3,enum=mafiapb.ActionOutcome" json:"outcome,omitempty"`
+	// Message key, see ActionInfo.confirmation
+	Confirmation string `protobuf:"bytes,3,opt,name=confirmation,proto3" json:"confirmation,omitempty"`
+	// Actions available after this one
+	Actions []*ActionInfo `protobuf:"bytes,4,rep,name=actions,proto3" json:"actions,omitempty"`
 }
 
-func (x *DayChange) Reset() {
-	*x = DayChange{}
+func (x *PerformActionResponse) Reset() {
+	*x = PerformActionResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_mafia_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -779,13 +1242,13 @@ func (x *DayChange) Reset() {
 	}
 }
 
-func (x *DayChange) String() string {
+func (x *PerformActionResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DayChange) ProtoMessage() {}
+func (*PerformActionResponse) ProtoMessage() {}
 
-func (x *DayChange) ProtoReflect() protoreflect.Message {
+func (x *PerformActionResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_mafia_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -797,21 +1260,50 @@ func (x *DayChange) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DayChange.ProtoReflect.Descriptor instead.
-func (*DayChange) Descriptor() ([]byte, []int) {
+// Deprecated: Use PerformActionResponse.ProtoReflect.Descriptor instead.
+func (*PerformActionResponse) Descriptor() ([]byte, []int) {
 	return file_mafia_proto_rawDescGZIP(), []int{15}
 }
 
-type PlayerKilled struct {
-	state         protoimpl.MessageState
+func (x *PerformActionResponse) GetActionId() string {
+	if x != nil {
+		return x.ActionId
+	}
+	return ""
+}
+
+func (x *PerformActionResponse) GetOutcome() ActionOutcome {
+	if x != nil {
+		return x.Outcome
+	}
+	return ActionOutcome_ACTION_OUTCOME_UNSPECIFIED
+}
+
+func (x *PerformActionResponse) GetConfirmation() string {
+	if x != nil {
+		return x.Confirmation
+	}
+	return ""
+}
+
+func (x *PerformActionResponse) GetActions() []*ActionInfo {
+	if x != nil {
+		return x.Actions
+	}
+	return nil
+}
+
+type AvailableActionsResponse struct {
+	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Player string `protobuf:"bytes,1,opt,name=player,proto3" json:"player,omitempty"`
+	Phase   Phase         `protobuf:"varint,1,opt,name=phase,proto3,enum=mafiapb.Phase" json:"phase,omitempty"`
+	Actions []*ActionInfo `protobuf:"bytes,2,rep,name=actions,proto3" json:"actions,omitempty"`
 }
 
-func (x *PlayerKilled) Reset() {
-	*x = PlayerKilled{}
+func (x *AvailableActionsResponse) Reset() {
+	*x = AvailableActionsResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_mafia_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -819,13 +1311,13 @@ func (x *PlayerKilled) Reset() {
 	}
 }
 
-func (x *PlayerKilled) String() string {
+func (x *AvailableActionsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PlayerKilled) ProtoMessage() {}
+func (*AvailableActionsResponse) ProtoMessage() {}
 
-func (x *PlayerKilled) ProtoReflect() protoreflect.Message {
+func (x *AvailableActionsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_mafia_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -837,28 +1329,36 @@ func (x *PlayerKilled) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PlayerKilled.ProtoReflect.Descriptor instead.
-func (*PlayerKilled) Descriptor() ([]byte, []int) {
+// Deprecated: Use AvailableActionsResponse.ProtoReflect.Descriptor instead.
+func (*AvailableActionsResponse) Descriptor() ([]byte, []int) {
 	return file_mafia_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *PlayerKilled) GetPlayer() string {
+func (x *AvailableActionsResponse) GetPhase() Phase {
 	if x != nil {
-		return x.Player
+		return x.Phase
 	}
-	return ""
+	return Phase_PHASE_UNSPECIFIED
 }
 
-type PlayerJailed struct {
+func (x *AvailableActionsResponse) GetActions() []*ActionInfo {
+	if x != nil {
+		return x.Actions
+	}
+	return nil
+}
+
+type VoteRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Player string `protobuf:"bytes,1,opt,name=player,proto3" json:"player,omitempty"`
+	Player *Player `protobuf:"bytes,1,opt,name=player,proto3" json:"player,omitempty"`
+	Voting int32   `protobuf:"varint,2,opt,name=voting,proto3" json:"voting,omitempty"`
 }
 
-func (x *PlayerJailed) Reset() {
-	*x = PlayerJailed{}
+func (x *VoteRequest) Reset() {
+	*x = VoteRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_mafia_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -866,13 +1366,13 @@ func (x *PlayerJailed) Reset() {
 	}
 }
 
-func (x *PlayerJailed) String() string {
+func (x *VoteRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PlayerJailed) ProtoMessage() {}
+func (*VoteRequest) ProtoMessage() {}
 
-func (x *PlayerJailed) ProtoReflect() protoreflect.Message {
+func (x *VoteRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_mafia_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -884,30 +1384,36 @@ func (x *PlayerJailed) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PlayerJailed.ProtoReflect.Descriptor instead.
-func (*PlayerJailed) Descriptor() ([]byte, []int) {
+// Deprecated: Use VoteRequest.ProtoReflect.Descriptor instead.
+func (*VoteRequest) Descriptor() ([]byte, []int) {
 	return file_mafia_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *PlayerJailed) GetPlayer() string {
+func (x *VoteRequest) GetPlayer() *Player {
 	if x != nil {
 		return x.Player
 	}
-	return ""
+	return nil
 }
 
-type GameEnd struct {
+func (x *VoteRequest) GetVoting() int32 {
+	if x != nil {
+		return x.Voting
+	}
+	return 0
+}
+
+type KillRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Won         string   `protobuf:"bytes,1,opt,name=won,proto3" json:"won,omitempty"`
-	PlayerNames []string `protobuf:"bytes,2,rep,name=player_names,json=playerNames,proto3" json:"player_names,omitempty"`
-	Roles       []string `protobuf:"bytes,3,rep,name=roles,proto3" json:"roles,omitempty"`
+	Player  *Player `protobuf:"bytes,1,opt,name=player,proto3" json:"player,omitempty"`
+	Killing int32   `protobuf:"varint,2,opt,name=killing,proto3" json:"killing,omitempty"`
 }
 
-func (x *GameEnd) Reset() {
-	*x = GameEnd{}
+func (x *KillRequest) Reset() {
+	*x = KillRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_mafia_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -915,13 +1421,13 @@ func (x *GameEnd) Reset() {
 	}
 }
 
-func (x *GameEnd) String() string {
+func (x *KillRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GameEnd) ProtoMessage() {}
+func (*KillRequest) ProtoMessage() {}
 
-func (x *GameEnd) ProtoReflect() protoreflect.Message {
+func (x *KillRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_mafia_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -933,40 +1439,36 @@ func (x *GameEnd) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GameEnd.ProtoReflect.Descriptor instead.
-func (*GameEnd) Descriptor() ([]byte, []int) {
+// Deprecated: Use KillRequest.ProtoReflect.Descriptor instead.
+func (*KillRequest) Descriptor() ([]byte, []int) {
 	return file_mafia_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *GameEnd) GetWon() string {
-	if x != nil {
-		return x.Won
-	}
-	return ""
-}
-
-func (x *GameEnd) GetPlayerNames() []string {
+func (x *KillRequest) GetPlayer() *Player {
 	if x != nil {
-		return x.PlayerNames
+		return x.Player
 	}
 	return nil
 }
 
-func (x *GameEnd) GetRoles() []string {
+func (x *KillRequest) GetKilling() int32 {
 	if x != nil {
-		return x.Roles
+		return x.Killing
 	}
-	return nil
+	return 0
 }
 
-type YouDead struct {
+type CheckRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Player   *Player `protobuf:"bytes,1,opt,name=player,proto3" json:"player,omitempty"`
+	Checking int32   `protobuf:"varint,2,opt,name=checking,proto3" json:"checking,omitempty"`
 }
 
-func (x *YouDead) Reset() {
-	*x = YouDead{}
+func (x *CheckRequest) Reset() {
+	*x = CheckRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_mafia_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -974,13 +1476,13 @@ func (x *YouDead) Reset() {
 	}
 }
 
-func (x *YouDead) String() string {
+func (x *CheckRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*YouDead) ProtoMessage() {}
+func (*CheckRequest) ProtoMessage() {}
 
-func (x *YouDead) ProtoReflect() protoreflect.Message {
+func (x *CheckRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_mafia_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -992,29 +1494,36 @@ func (x *YouDead) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use YouDead.ProtoReflect.Descriptor instead.
-func (*YouDead) Descriptor() ([]byte, []int) {
+// Deprecated: Use CheckRequest.ProtoReflect.Descriptor instead.
+func (*CheckRequest) Descriptor() ([]byte, []int) {
 	return file_mafia_proto_rawDescGZIP(), []int{19}
 }
 
-type GameEvent struct {
+func (x *CheckRequest) GetPlayer() *Player {
+	if x != nil {
+		return x.Player
+	}
+	return nil
+}
+
+func (x *CheckRequest) GetChecking() int32 {
+	if x != nil {
+		return x.Checking
+	}
+	return 0
+}
+
+type CheckResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Type string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
-	// Types that are assignable to Event:
-	//
-	//	*GameEvent_Day
-	//	*GameEvent_Killed
-	//	*GameEvent_Jailed
-	//	*GameEvent_End
-	//	*GameEvent_Dead
-	Event isGameEvent_Event `protobuf_oneof:"event"`
+	// Deprecated: check is resolved at dawn, result comes with EVENT_TYPE_CHECK_RESULT
+	Role string `protobuf:"bytes,1,opt,name=role,proto3" json:"role,omitempty"`
 }
 
-func (x *GameEvent) Reset() {
-	*x = GameEvent{}
+func (x *CheckResponse) Reset() {
+	*x = CheckResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_mafia_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1022,13 +1531,13 @@ func (x *GameEvent) Reset() {
 	}
 }
 
-func (x *GameEvent) String() string {
+func (x *CheckResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GameEvent) ProtoMessage() {}
+func (*CheckResponse) ProtoMessage() {}
 
-func (x *GameEvent) ProtoReflect() protoreflect.Message {
+func (x *CheckResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_mafia_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1040,234 +1549,2122 @@ func (x *GameEvent) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GameEvent.ProtoReflect.Descriptor instead.
-func (*GameEvent) Descriptor() ([]byte, []int) {
+// Deprecated: Use CheckResponse.ProtoReflect.Descriptor instead.
+func (*CheckResponse) Descriptor() ([]byte, []int) {
 	return file_mafia_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *GameEvent) GetType() string {
+func (x *CheckResponse) GetRole() string {
 	if x != nil {
-		return x.Type
+		return x.Role
 	}
 	return ""
 }
 
-func (m *GameEvent) GetEvent() isGameEvent_Event {
-	if m != nil {
-		return m.Event
-	}
-	return nil
+type PhaseChanged struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Phase Phase `protobuf:"varint,1,opt,name=phase,proto3,enum=mafiapb.Phase" json:"phase,omitempty"`
+	// Day number starting from 1, night belongs to the day before it
+	Day int32 `protobuf:"varint,2,opt,name=day,proto3" json:"day,omitempty"`
+	// Unset if phase has no time limit
+	Deadline *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=deadline,proto3" json:"deadline,omitempty"`
 }
 
-func (x *GameEvent) GetDay() *DayChange {
-	if x, ok := x.GetEvent().(*GameEvent_Day); ok {
-		return x.Day
+func (x *PhaseChanged) Reset() {
+	*x = PhaseChanged{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mafia_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return nil
 }
 
-func (x *GameEvent) GetKilled() *PlayerKilled {
-	if x, ok := x.GetEvent().(*GameEvent_Killed); ok {
-		return x.Killed
+func (x *PhaseChanged) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PhaseChanged) ProtoMessage() {}
+
+func (x *PhaseChanged) ProtoReflect() protoreflect.Message {
+	mi := &file_mafia_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *GameEvent) GetJailed() *PlayerJailed {
-	if x, ok := x.GetEvent().(*GameEvent_Jailed); ok {
-		return x.Jailed
+// Deprecated: Use PhaseChanged.ProtoReflect.Descriptor instead.
+func (*PhaseChanged) Descriptor() ([]byte, []int) {
+	return file_mafia_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *PhaseChanged) GetPhase() Phase {
+	if x != nil {
+		return x.Phase
 	}
-	return nil
+	return Phase_PHASE_UNSPECIFIED
 }
 
-func (x *GameEvent) GetEnd() *GameEnd {
-	if x, ok := x.GetEvent().(*GameEvent_End); ok {
-		return x.End
+func (x *PhaseChanged) GetDay() int32 {
+	if x != nil {
+		return x.Day
 	}
-	return nil
+	return 0
 }
 
-func (x *GameEvent) GetDead() *YouDead {
-	if x, ok := x.GetEvent().(*GameEvent_Dead); ok {
-		return x.Dead
+func (x *PhaseChanged) GetDeadline() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Deadline
 	}
 	return nil
 }
 
-type isGameEvent_Event interface {
-	isGameEvent_Event()
+type PlayerKilled struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Player string `protobuf:"bytes,1,opt,name=player,proto3" json:"player,omitempty"`
 }
 
-type GameEvent_Day struct {
-	Day *DayChange `protobuf:"bytes,2,opt,name=day,proto3,oneof"`
+func (x *PlayerKilled) Reset() {
+	*x = PlayerKilled{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mafia_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
 }
 
-type GameEvent_Killed struct {
-	Killed *PlayerKilled `protobuf:"bytes,3,opt,name=killed,proto3,oneof"`
+func (x *PlayerKilled) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-type GameEvent_Jailed struct {
-	Jailed *PlayerJailed `protobuf:"bytes,4,opt,name=jailed,proto3,oneof"`
+func (*PlayerKilled) ProtoMessage() {}
+
+func (x *PlayerKilled) ProtoReflect() protoreflect.Message {
+	mi := &file_mafia_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-type GameEvent_End struct {
-	End *GameEnd `protobuf:"bytes,5,opt,name=end,proto3,oneof"`
+// Deprecated: Use PlayerKilled.ProtoReflect.Descriptor instead.
+func (*PlayerKilled) Descriptor() ([]byte, []int) {
+	return file_mafia_proto_rawDescGZIP(), []int{22}
 }
 
-type GameEvent_Dead struct {
-	Dead *YouDead `protobuf:"bytes,6,opt,name=dead,proto3,oneof"`
+func (x *PlayerKilled) GetPlayer() string {
+	if x != nil {
+		return x.Player
+	}
+	return ""
 }
 
-func (*GameEvent_Day) isGameEvent_Event() {}
+type PlayerJailed struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Player string `protobuf:"bytes,1,opt,name=player,proto3" json:"player,omitempty"`
+}
+
+func (x *PlayerJailed) Reset() {
+	*x = PlayerJailed{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mafia_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PlayerJailed) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlayerJailed) ProtoMessage() {}
+
+func (x *PlayerJailed) ProtoReflect() protoreflect.Message {
+	mi := &file_mafia_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlayerJailed.ProtoReflect.Descriptor instead.
+func (*PlayerJailed) Descriptor() ([]byte, []int) {
+	return file_mafia_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *PlayerJailed) GetPlayer() string {
+	if x != nil {
+		return x.Player
+	}
+	return ""
+}
+
+type GameEnd struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Won         string   `protobuf:"bytes,1,opt,name=won,proto3" json:"won,omitempty"`
+	PlayerNames []string `protobuf:"bytes,2,rep,name=player_names,json=playerNames,proto3" json:"player_names,omitempty"`
+	Roles       []string `protobuf:"bytes,3,rep,name=roles,proto3" json:"roles,omitempty"`
+}
+
+func (x *GameEnd) Reset() {
+	*x = GameEnd{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mafia_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GameEnd) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GameEnd) ProtoMessage() {}
+
+func (x *GameEnd) ProtoReflect() protoreflect.Message {
+	mi := &file_mafia_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GameEnd.ProtoReflect.Descriptor instead.
+func (*GameEnd) Descriptor() ([]byte, []int) {
+	return file_mafia_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *GameEnd) GetWon() string {
+	if x != nil {
+		return x.Won
+	}
+	return ""
+}
+
+func (x *GameEnd) GetPlayerNames() []string {
+	if x != nil {
+		return x.PlayerNames
+	}
+	return nil
+}
+
+func (x *GameEnd) GetRoles() []string {
+	if x != nil {
+		return x.Roles
+	}
+	return nil
+}
+
+type YouDead struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *YouDead) Reset() {
+	*x = YouDead{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mafia_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *YouDead) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*YouDead) ProtoMessage() {}
+
+func (x *YouDead) ProtoReflect() protoreflect.Message {
+	mi := &file_mafia_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use YouDead.ProtoReflect.Descriptor instead.
+func (*YouDead) Descriptor() ([]byte, []int) {
+	return file_mafia_proto_rawDescGZIP(), []int{25}
+}
+
+// Sent when votes are cast in seat order and next player has to vote
+type VoteTurn struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pid    int32  `protobuf:"varint,1,opt,name=pid,proto3" json:"pid,omitempty"`
+	Player string `protobuf:"bytes,2,opt,name=player,proto3" json:"player,omitempty"`
+}
+
+func (x *VoteTurn) Reset() {
+	*x = VoteTurn{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mafia_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VoteTurn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VoteTurn) ProtoMessage() {}
+
+func (x *VoteTurn) ProtoReflect() protoreflect.Message {
+	mi := &file_mafia_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VoteTurn.ProtoReflect.Descriptor instead.
+func (*VoteTurn) Descriptor() ([]byte, []int) {
+	return file_mafia_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *VoteTurn) GetPid() int32 {
+	if x != nil {
+		return x.Pid
+	}
+	return 0
+}
+
+func (x *VoteTurn) GetPlayer() string {
+	if x != nil {
+		return x.Player
+	}
+	return ""
+}
+
+// Sent when next player starts their speech at day time
+type SpeechTurn struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pid    int32  `protobuf:"varint,1,opt,name=pid,proto3" json:"pid,omitempty"`
+	Player string `protobuf:"bytes,2,opt,name=player,proto3" json:"player,omitempty"`
+	// Unset if speech has no time limit
+	Deadline *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=deadline,proto3" json:"deadline,omitempty"`
+}
+
+func (x *SpeechTurn) Reset() {
+	*x = SpeechTurn{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mafia_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SpeechTurn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SpeechTurn) ProtoMessage() {}
+
+func (x *SpeechTurn) ProtoReflect() protoreflect.Message {
+	mi := &file_mafia_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SpeechTurn.ProtoReflect.Descriptor instead.
+func (*SpeechTurn) Descriptor() ([]byte, []int) {
+	return file_mafia_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *SpeechTurn) GetPid() int32 {
+	if x != nil {
+		return x.Pid
+	}
+	return 0
+}
+
+func (x *SpeechTurn) GetPlayer() string {
+	if x != nil {
+		return x.Player
+	}
+	return ""
+}
+
+func (x *SpeechTurn) GetDeadline() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Deadline
+	}
+	return nil
+}
+
+type ServerShutdown struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Running games may be finished within drain_seconds, 0 means immediate stop
+	DrainSeconds int32 `protobuf:"varint,1,opt,name=drain_seconds,json=drainSeconds,proto3" json:"drain_seconds,omitempty"`
+}
+
+func (x *ServerShutdown) Reset() {
+	*x = ServerShutdown{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mafia_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ServerShutdown) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServerShutdown) ProtoMessage() {}
+
+func (x *ServerShutdown) ProtoReflect() protoreflect.Message {
+	mi := &file_mafia_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServerShutdown.ProtoReflect.Descriptor instead.
+func (*ServerShutdown) Descriptor() ([]byte, []int) {
+	return file_mafia_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *ServerShutdown) GetDrainSeconds() int32 {
+	if x != nil {
+		return x.DrainSeconds
+	}
+	return 0
+}
+
+type GameEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Schema version, see EventSchemaVersion
+	Version int32     `protobuf:"varint,8,opt,name=version,proto3" json:"version,omitempty"`
+	Type    EventType `protobuf:"varint,9,opt,name=type,proto3,enum=mafiapb.EventType" json:"type,omitempty"`
+	// Increases by one with every event sent to the subscriber, starting from 1
+	Seq  uint64                 `protobuf:"varint,10,opt,name=seq,proto3" json:"seq,omitempty"`
+	Time *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=time,proto3" json:"time,omitempty"`
+	// Types that are assignable to Event:
+	//	*GameEvent_PhaseChanged
+	//	*GameEvent_Killed
+	//	*GameEvent_Jailed
+	//	*GameEvent_End
+	//	*GameEvent_Dead
+	//	*GameEvent_Shutdown
+	//	*GameEvent_CheckResult
+	//	*GameEvent_VoteTurn
+	//	*GameEvent_SpeechTurn
+	Event isGameEvent_Event `protobuf_oneof:"event"`
+}
+
+func (x *GameEvent) Reset() {
+	*x = GameEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mafia_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GameEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GameEvent) ProtoMessage() {}
+
+func (x *GameEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_mafia_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GameEvent.ProtoReflect.Descriptor instead.
+func (*GameEvent) Descriptor() ([]byte, []int) {
+	return file_mafia_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *GameEvent) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *GameEvent) GetType() EventType {
+	if x != nil {
+		return x.Type
+	}
+	return EventType_EVENT_TYPE_UNSPECIFIED
+}
+
+func (x *GameEvent) GetSeq() uint64 {
+	if x != nil {
+		return x.Seq
+	}
+	return 0
+}
+
+func (x *GameEvent) GetTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Time
+	}
+	return nil
+}
+
+func (m *GameEvent) GetEvent() isGameEvent_Event {
+	if m != nil {
+		return m.Event
+	}
+	return nil
+}
+
+func (x *GameEvent) GetPhaseChanged() *PhaseChanged {
+	if x, ok := x.GetEvent().(*GameEvent_PhaseChanged); ok {
+		return x.PhaseChanged
+	}
+	return nil
+}
+
+func (x *GameEvent) GetKilled() *PlayerKilled {
+	if x, ok := x.GetEvent().(*GameEvent_Killed); ok {
+		return x.Killed
+	}
+	return nil
+}
+
+func (x *GameEvent) GetJailed() *PlayerJailed {
+	if x, ok := x.GetEvent().(*GameEvent_Jailed); ok {
+		return x.Jailed
+	}
+	return nil
+}
+
+func (x *GameEvent) GetEnd() *GameEnd {
+	if x, ok := x.GetEvent().(*GameEvent_End); ok {
+		return x.End
+	}
+	return nil
+}
+
+func (x *GameEvent) GetDead() *YouDead {
+	if x, ok := x.GetEvent().(*GameEvent_Dead); ok {
+		return x.Dead
+	}
+	return nil
+}
+
+func (x *GameEvent) GetShutdown() *ServerShutdown {
+	if x, ok := x.GetEvent().(*GameEvent_Shutdown); ok {
+		return x.Shutdown
+	}
+	return nil
+}
+
+func (x *GameEvent) GetCheckResult() *CheckResult {
+	if x, ok := x.GetEvent().(*GameEvent_CheckResult); ok {
+		return x.CheckResult
+	}
+	return nil
+}
+
+func (x *GameEvent) GetVoteTurn() *VoteTurn {
+	if x, ok := x.GetEvent().(*GameEvent_VoteTurn); ok {
+		return x.VoteTurn
+	}
+	return nil
+}
+
+func (x *GameEvent) GetSpeechTurn() *SpeechTurn {
+	if x, ok := x.GetEvent().(*GameEvent_SpeechTurn); ok {
+		return x.SpeechTurn
+	}
+	return nil
+}
+
+type isGameEvent_Event interface {
+	isGameEvent_Event()
+}
+
+type GameEvent_PhaseChanged struct {
+	PhaseChanged *PhaseChanged `protobuf:"bytes,12,opt,name=phase_changed,json=phaseChanged,proto3,oneof"`
+}
+
+type GameEvent_Killed struct {
+	Killed *PlayerKilled `protobuf:"bytes,3,opt,name=killed,proto3,oneof"`
+}
+
+type GameEvent_Jailed struct {
+	Jailed *PlayerJailed `protobuf:"bytes,4,opt,name=jailed,proto3,oneof"`
+}
+
+type GameEvent_End struct {
+	End *GameEnd `protobuf:"bytes,5,opt,name=end,proto3,oneof"`
+}
+
+type GameEvent_Dead struct {
+	Dead *YouDead `protobuf:"bytes,6,opt,name=dead,proto3,oneof"`
+}
+
+type GameEvent_Shutdown struct {
+	Shutdown *ServerShutdown `protobuf:"bytes,7,opt,name=shutdown,proto3,oneof"`
+}
+
+type GameEvent_CheckResult struct {
+	// Sent to commissar at dawn
+	CheckResult *CheckResult `protobuf:"bytes,13,opt,name=check_result,json=checkResult,proto3,oneof"`
+}
+
+type GameEvent_VoteTurn struct {
+	VoteTurn *VoteTurn `protobuf:"bytes,14,opt,name=vote_turn,json=voteTurn,proto3,oneof"`
+}
+
+type GameEvent_SpeechTurn struct {
+	SpeechTurn *SpeechTurn `protobuf:"bytes,15,opt,name=speech_turn,json=speechTurn,proto3,oneof"`
+}
+
+func (*GameEvent_PhaseChanged) isGameEvent_Event() {}
 
 func (*GameEvent_Killed) isGameEvent_Event() {}
 
-func (*GameEvent_Jailed) isGameEvent_Event() {}
+func (*GameEvent_Jailed) isGameEvent_Event() {}
+
+func (*GameEvent_End) isGameEvent_Event() {}
+
+func (*GameEvent_Dead) isGameEvent_Event() {}
+
+func (*GameEvent_Shutdown) isGameEvent_Event() {}
+
+func (*GameEvent_CheckResult) isGameEvent_Event() {}
+
+func (*GameEvent_VoteTurn) isGameEvent_Event() {}
+
+func (*GameEvent_SpeechTurn) isGameEvent_Event() {}
+
+type StateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Player *Player `protobuf:"bytes,1,opt,name=player,proto3" json:"player,omitempty"`
+}
+
+func (x *StateRequest) Reset() {
+	*x = StateRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mafia_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StateRequest) ProtoMessage() {}
+
+func (x *StateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mafia_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StateRequest.ProtoReflect.Descriptor instead.
+func (*StateRequest) Descriptor() ([]byte, []int) {
+	return file_mafia_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *StateRequest) GetPlayer() *Player {
+	if x != nil {
+		return x.Player
+	}
+	return nil
+}
+
+type Seat struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pid       int32  `protobuf:"varint,1,opt,name=pid,proto3" json:"pid,omitempty"`
+	Name      string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Alive     bool   `protobuf:"varint,3,opt,name=alive,proto3" json:"alive,omitempty"`
+	Connected bool   `protobuf:"varint,4,opt,name=connected,proto3" json:"connected,omitempty"`
+	// Empty if role is unknown to the caller
+	Role  string `protobuf:"bytes,5,opt,name=role,proto3" json:"role,omitempty"`
+	Fouls int32  `protobuf:"varint,6,opt,name=fouls,proto3" json:"fouls,omitempty"`
+}
+
+func (x *Seat) Reset() {
+	*x = Seat{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mafia_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Seat) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Seat) ProtoMessage() {}
+
+func (x *Seat) ProtoReflect() protoreflect.Message {
+	mi := &file_mafia_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Seat.ProtoReflect.Descriptor instead.
+func (*Seat) Descriptor() ([]byte, []int) {
+	return file_mafia_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *Seat) GetPid() int32 {
+	if x != nil {
+		return x.Pid
+	}
+	return 0
+}
+
+func (x *Seat) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Seat) GetAlive() bool {
+	if x != nil {
+		return x.Alive
+	}
+	return false
+}
+
+func (x *Seat) GetConnected() bool {
+	if x != nil {
+		return x.Connected
+	}
+	return false
+}
+
+func (x *Seat) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *Seat) GetFouls() int32 {
+	if x != nil {
+		return x.Fouls
+	}
+	return 0
+}
+
+type CheckResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Day int32 `protobuf:"varint,1,opt,name=day,proto3" json:"day,omitempty"`
+	Pid int32 `protobuf:"varint,2,opt,name=pid,proto3" json:"pid,omitempty"`
+	// Empty if the action doesn't reveal the role, e.g. the player is not the one sought
+	Role string `protobuf:"bytes,3,opt,name=role,proto3" json:"role,omitempty"`
+	// Night action kind, check or seek
+	Action string `protobuf:"bytes,4,opt,name=action,proto3" json:"action,omitempty"`
+}
+
+func (x *CheckResult) Reset() {
+	*x = CheckResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mafia_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CheckResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckResult) ProtoMessage() {}
+
+func (x *CheckResult) ProtoReflect() protoreflect.Message {
+	mi := &file_mafia_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckResult.ProtoReflect.Descriptor instead.
+func (*CheckResult) Descriptor() ([]byte, []int) {
+	return file_mafia_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *CheckResult) GetDay() int32 {
+	if x != nil {
+		return x.Day
+	}
+	return 0
+}
+
+func (x *CheckResult) GetPid() int32 {
+	if x != nil {
+		return x.Pid
+	}
+	return 0
+}
+
+func (x *CheckResult) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *CheckResult) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+type GameStateResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Phase Phase `protobuf:"varint,1,opt,name=phase,proto3,enum=mafiapb.Phase" json:"phase,omitempty"`
+	Day   int32 `protobuf:"varint,2,opt,name=day,proto3" json:"day,omitempty"`
+	// Unset if phase has no time limit
+	Deadline *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=deadline,proto3" json:"deadline,omitempty"`
+	Paused   bool                   `protobuf:"varint,4,opt,name=paused,proto3" json:"paused,omitempty"`
+	Ended    bool                   `protobuf:"varint,5,opt,name=ended,proto3" json:"ended,omitempty"`
+	Seats    []*Seat                `protobuf:"bytes,6,rep,name=seats,proto3" json:"seats,omitempty"`
+	// Caller's own seat and private knowledge
+	Pid    int32          `protobuf:"varint,7,opt,name=pid,proto3" json:"pid,omitempty"`
+	Role   string         `protobuf:"bytes,8,opt,name=role,proto3" json:"role,omitempty"`
+	Alive  bool           `protobuf:"varint,9,opt,name=alive,proto3" json:"alive,omitempty"`
+	Checks []*CheckResult `protobuf:"bytes,10,rep,name=checks,proto3" json:"checks,omitempty"`
+	// Filled at day time and in PHASE_VOTING: votes[pid] is pid voted for, -1 - skipped voting, -2 - not voted yet
+	Votes      []int32 `protobuf:"varint,11,rep,packed,name=votes,proto3" json:"votes,omitempty"`
+	VotesCount []int32 `protobuf:"varint,12,rep,packed,name=votes_count,json=votesCount,proto3" json:"votes_count,omitempty"`
+	// Sequence number of the last event sent to the caller
+	LastSeq uint64 `protobuf:"varint,13,opt,name=last_seq,json=lastSeq,proto3" json:"last_seq,omitempty"`
+	// Pids of players having last words in PHASE_LAST_WORDS
+	Speakers []int32 `protobuf:"varint,14,rep,packed,name=speakers,proto3" json:"speakers,omitempty"`
+	// Pids of candidates put up for the vote, in nomination order
+	Nominees []int32 `protobuf:"varint,15,rep,packed,name=nominees,proto3" json:"nominees,omitempty"`
+	// Pid of player voting now if votes are cast in seat order, -1 otherwise
+	Voter int32 `protobuf:"varint,16,opt,name=voter,proto3" json:"voter,omitempty"`
+	// Pid of player giving a speech now, -1 if there are no speeches
+	Speaker int32 `protobuf:"varint,17,opt,name=speaker,proto3" json:"speaker,omitempty"`
+}
+
+func (x *GameStateResponse) Reset() {
+	*x = GameStateResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mafia_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GameStateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GameStateResponse) ProtoMessage() {}
+
+func (x *GameStateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mafia_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GameStateResponse.ProtoReflect.Descriptor instead.
+func (*GameStateResponse) Descriptor() ([]byte, []int) {
+	return file_mafia_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *GameStateResponse) GetPhase() Phase {
+	if x != nil {
+		return x.Phase
+	}
+	return Phase_PHASE_UNSPECIFIED
+}
+
+func (x *GameStateResponse) GetDay() int32 {
+	if x != nil {
+		return x.Day
+	}
+	return 0
+}
+
+func (x *GameStateResponse) GetDeadline() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Deadline
+	}
+	return nil
+}
+
+func (x *GameStateResponse) GetPaused() bool {
+	if x != nil {
+		return x.Paused
+	}
+	return false
+}
+
+func (x *GameStateResponse) GetEnded() bool {
+	if x != nil {
+		return x.Ended
+	}
+	return false
+}
+
+func (x *GameStateResponse) GetSeats() []*Seat {
+	if x != nil {
+		return x.Seats
+	}
+	return nil
+}
+
+func (x *GameStateResponse) GetPid() int32 {
+	if x != nil {
+		return x.Pid
+	}
+	return 0
+}
+
+func (x *GameStateResponse) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *GameStateResponse) GetAlive() bool {
+	if x != nil {
+		return x.Alive
+	}
+	return false
+}
+
+func (x *GameStateResponse) GetChecks() []*CheckResult {
+	if x != nil {
+		return x.Checks
+	}
+	return nil
+}
+
+func (x *GameStateResponse) GetVotes() []int32 {
+	if x != nil {
+		return x.Votes
+	}
+	return nil
+}
+
+func (x *GameStateResponse) GetVotesCount() []int32 {
+	if x != nil {
+		return x.VotesCount
+	}
+	return nil
+}
+
+func (x *GameStateResponse) GetLastSeq() uint64 {
+	if x != nil {
+		return x.LastSeq
+	}
+	return 0
+}
+
+func (x *GameStateResponse) GetSpeakers() []int32 {
+	if x != nil {
+		return x.Speakers
+	}
+	return nil
+}
+
+func (x *GameStateResponse) GetNominees() []int32 {
+	if x != nil {
+		return x.Nominees
+	}
+	return nil
+}
+
+func (x *GameStateResponse) GetVoter() int32 {
+	if x != nil {
+		return x.Voter
+	}
+	return 0
+}
+
+func (x *GameStateResponse) GetSpeaker() int32 {
+	if x != nil {
+		return x.Speaker
+	}
+	return 0
+}
+
+type LobbyInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PlayerNames []string `protobuf:"bytes,1,rep,name=player_names,json=playerNames,proto3" json:"player_names,omitempty"`
+	Capacity    int32    `protobuf:"varint,2,opt,name=capacity,proto3" json:"capacity,omitempty"`
+}
+
+func (x *LobbyInfo) Reset() {
+	*x = LobbyInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mafia_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LobbyInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LobbyInfo) ProtoMessage() {}
+
+func (x *LobbyInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_mafia_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LobbyInfo.ProtoReflect.Descriptor instead.
+func (*LobbyInfo) Descriptor() ([]byte, []int) {
+	return file_mafia_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *LobbyInfo) GetPlayerNames() []string {
+	if x != nil {
+		return x.PlayerNames
+	}
+	return nil
+}
+
+func (x *LobbyInfo) GetCapacity() int32 {
+	if x != nil {
+		return x.Capacity
+	}
+	return 0
+}
+
+type GameInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id          string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Addr        string   `protobuf:"bytes,2,opt,name=addr,proto3" json:"addr,omitempty"`
+	PlayerNames []string `protobuf:"bytes,3,rep,name=player_names,json=playerNames,proto3" json:"player_names,omitempty"`
+	Alive       int32    `protobuf:"varint,4,opt,name=alive,proto3" json:"alive,omitempty"`
+	Paused      bool     `protobuf:"varint,5,opt,name=paused,proto3" json:"paused,omitempty"`
+}
+
+func (x *GameInfo) Reset() {
+	*x = GameInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mafia_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GameInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GameInfo) ProtoMessage() {}
+
+func (x *GameInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_mafia_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GameInfo.ProtoReflect.Descriptor instead.
+func (*GameInfo) Descriptor() ([]byte, []int) {
+	return file_mafia_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *GameInfo) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *GameInfo) GetAddr() string {
+	if x != nil {
+		return x.Addr
+	}
+	return ""
+}
+
+func (x *GameInfo) GetPlayerNames() []string {
+	if x != nil {
+		return x.PlayerNames
+	}
+	return nil
+}
+
+func (x *GameInfo) GetAlive() int32 {
+	if x != nil {
+		return x.Alive
+	}
+	return 0
+}
+
+func (x *GameInfo) GetPaused() bool {
+	if x != nil {
+		return x.Paused
+	}
+	return false
+}
+
+type AdminListResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Lobbies []*LobbyInfo `protobuf:"bytes,1,rep,name=lobbies,proto3" json:"lobbies,omitempty"`
+	Games   []*GameInfo  `protobuf:"bytes,2,rep,name=games,proto3" json:"games,omitempty"`
+}
+
+func (x *AdminListResponse) Reset() {
+	*x = AdminListResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mafia_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminListResponse) ProtoMessage() {}
+
+func (x *AdminListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mafia_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminListResponse.ProtoReflect.Descriptor instead.
+func (*AdminListResponse) Descriptor() ([]byte, []int) {
+	return file_mafia_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *AdminListResponse) GetLobbies() []*LobbyInfo {
+	if x != nil {
+		return x.Lobbies
+	}
+	return nil
+}
+
+func (x *AdminListResponse) GetGames() []*GameInfo {
+	if x != nil {
+		return x.Games
+	}
+	return nil
+}
+
+type GameIdRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GameId string `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+}
+
+func (x *GameIdRequest) Reset() {
+	*x = GameIdRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mafia_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GameIdRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GameIdRequest) ProtoMessage() {}
+
+func (x *GameIdRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mafia_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GameIdRequest.ProtoReflect.Descriptor instead.
+func (*GameIdRequest) Descriptor() ([]byte, []int) {
+	return file_mafia_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *GameIdRequest) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+type PlayerInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-func (*GameEvent_End) isGameEvent_Event() {}
+	Name  string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Addr  string `protobuf:"bytes,2,opt,name=addr,proto3" json:"addr,omitempty"`
+	Role  string `protobuf:"bytes,3,opt,name=role,proto3" json:"role,omitempty"`
+	Alive bool   `protobuf:"varint,4,opt,name=alive,proto3" json:"alive,omitempty"`
+	Vote  int32  `protobuf:"varint,5,opt,name=vote,proto3" json:"vote,omitempty"`
+	Fouls int32  `protobuf:"varint,6,opt,name=fouls,proto3" json:"fouls,omitempty"`
+}
 
-func (*GameEvent_Dead) isGameEvent_Event() {}
+func (x *PlayerInfo) Reset() {
+	*x = PlayerInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mafia_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PlayerInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlayerInfo) ProtoMessage() {}
+
+func (x *PlayerInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_mafia_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlayerInfo.ProtoReflect.Descriptor instead.
+func (*PlayerInfo) Descriptor() ([]byte, []int) {
+	return file_mafia_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *PlayerInfo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PlayerInfo) GetAddr() string {
+	if x != nil {
+		return x.Addr
+	}
+	return ""
+}
+
+func (x *PlayerInfo) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *PlayerInfo) GetAlive() bool {
+	if x != nil {
+		return x.Alive
+	}
+	return false
+}
+
+func (x *PlayerInfo) GetVote() int32 {
+	if x != nil {
+		return x.Vote
+	}
+	return 0
+}
+
+func (x *PlayerInfo) GetFouls() int32 {
+	if x != nil {
+		return x.Fouls
+	}
+	return 0
+}
+
+type AdminGameState struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id         string        `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Phase      string        `protobuf:"bytes,2,opt,name=phase,proto3" json:"phase,omitempty"`
+	Paused     bool          `protobuf:"varint,3,opt,name=paused,proto3" json:"paused,omitempty"`
+	Players    []*PlayerInfo `protobuf:"bytes,4,rep,name=players,proto3" json:"players,omitempty"`
+	VotesCount []int32       `protobuf:"varint,5,rep,packed,name=votes_count,json=votesCount,proto3" json:"votes_count,omitempty"`
+}
+
+func (x *AdminGameState) Reset() {
+	*x = AdminGameState{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mafia_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminGameState) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminGameState) ProtoMessage() {}
+
+func (x *AdminGameState) ProtoReflect() protoreflect.Message {
+	mi := &file_mafia_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminGameState.ProtoReflect.Descriptor instead.
+func (*AdminGameState) Descriptor() ([]byte, []int) {
+	return file_mafia_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *AdminGameState) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *AdminGameState) GetPhase() string {
+	if x != nil {
+		return x.Phase
+	}
+	return ""
+}
+
+func (x *AdminGameState) GetPaused() bool {
+	if x != nil {
+		return x.Paused
+	}
+	return false
+}
+
+func (x *AdminGameState) GetPlayers() []*PlayerInfo {
+	if x != nil {
+		return x.Players
+	}
+	return nil
+}
+
+func (x *AdminGameState) GetVotesCount() []int32 {
+	if x != nil {
+		return x.VotesCount
+	}
+	return nil
+}
+
+type PauseGameRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GameId string `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	Paused bool   `protobuf:"varint,2,opt,name=paused,proto3" json:"paused,omitempty"`
+}
+
+func (x *PauseGameRequest) Reset() {
+	*x = PauseGameRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mafia_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PauseGameRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PauseGameRequest) ProtoMessage() {}
+
+func (x *PauseGameRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mafia_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PauseGameRequest.ProtoReflect.Descriptor instead.
+func (*PauseGameRequest) Descriptor() ([]byte, []int) {
+	return file_mafia_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *PauseGameRequest) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+func (x *PauseGameRequest) GetPaused() bool {
+	if x != nil {
+		return x.Paused
+	}
+	return false
+}
+
+type KickRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Ban  bool   `protobuf:"varint,2,opt,name=ban,proto3" json:"ban,omitempty"`
+}
+
+func (x *KickRequest) Reset() {
+	*x = KickRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mafia_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *KickRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KickRequest) ProtoMessage() {}
+
+func (x *KickRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mafia_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KickRequest.ProtoReflect.Descriptor instead.
+func (*KickRequest) Descriptor() ([]byte, []int) {
+	return file_mafia_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *KickRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *KickRequest) GetBan() bool {
+	if x != nil {
+		return x.Ban
+	}
+	return false
+}
+
+type FoulRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *FoulRequest) Reset() {
+	*x = FoulRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mafia_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FoulRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FoulRequest) ProtoMessage() {}
+
+func (x *FoulRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mafia_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FoulRequest.ProtoReflect.Descriptor instead.
+func (*FoulRequest) Descriptor() ([]byte, []int) {
+	return file_mafia_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *FoulRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type AnnounceRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Msg string `protobuf:"bytes,1,opt,name=msg,proto3" json:"msg,omitempty"`
+}
+
+func (x *AnnounceRequest) Reset() {
+	*x = AnnounceRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mafia_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AnnounceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AnnounceRequest) ProtoMessage() {}
+
+func (x *AnnounceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mafia_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AnnounceRequest.ProtoReflect.Descriptor instead.
+func (*AnnounceRequest) Descriptor() ([]byte, []int) {
+	return file_mafia_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *AnnounceRequest) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
 
 var File_mafia_proto protoreflect.FileDescriptor
 
 var file_mafia_proto_rawDesc = []byte{
 	0x0a, 0x0b, 0x6d, 0x61, 0x66, 0x69, 0x61, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x07, 0x6d,
-	0x61, 0x66, 0x69, 0x61, 0x70, 0x62, 0x22, 0x30, 0x0a, 0x06, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72,
-	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
-	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x64, 0x64, 0x72, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x04, 0x61, 0x64, 0x64, 0x72, 0x22, 0x36, 0x0a, 0x0b, 0x4a, 0x6f, 0x69, 0x6e,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x27, 0x0a, 0x06, 0x70, 0x6c, 0x61, 0x79, 0x65,
-	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x6d, 0x61, 0x66, 0x69, 0x61, 0x70,
+	0x61, 0x66, 0x69, 0x61, 0x70, 0x62, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x30, 0x0a, 0x06, 0x50, 0x6c, 0x61, 0x79, 0x65,
+	0x72, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x64, 0x64, 0x72, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x61, 0x64, 0x64, 0x72, 0x22, 0x36, 0x0a, 0x0b, 0x4a, 0x6f, 0x69,
+	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x27, 0x0a, 0x06, 0x70, 0x6c, 0x61, 0x79,
+	0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x6d, 0x61, 0x66, 0x69, 0x61,
+	0x70, 0x62, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x52, 0x06, 0x70, 0x6c, 0x61, 0x79, 0x65,
+	0x72, 0x22, 0x07, 0x0a, 0x05, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x37, 0x0a, 0x12, 0x4d, 0x65,
+	0x6d, 0x62, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x21, 0x0a, 0x0c, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x4e, 0x61,
+	0x6d, 0x65, 0x73, 0x22, 0x4a, 0x0a, 0x11, 0x41, 0x6c, 0x69, 0x76, 0x65, 0x4c, 0x69, 0x73, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x6c, 0x61, 0x79,
+	0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b,
+	0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x70,
+	0x69, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x05, 0x52, 0x04, 0x70, 0x69, 0x64, 0x73, 0x22,
+	0x4f, 0x0a, 0x12, 0x53, 0x65, 0x6e, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x73, 0x67, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x6d, 0x73, 0x67, 0x12, 0x27, 0x0a, 0x06, 0x70, 0x6c, 0x61, 0x79, 0x65,
+	0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x6d, 0x61, 0x66, 0x69, 0x61, 0x70,
 	0x62, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x52, 0x06, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72,
-	0x22, 0x07, 0x0a, 0x05, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x37, 0x0a, 0x12, 0x4d, 0x65, 0x6d,
-	0x62, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x21, 0x0a, 0x0c, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x4e, 0x61, 0x6d,
-	0x65, 0x73, 0x22, 0x4a, 0x0a, 0x11, 0x41, 0x6c, 0x69, 0x76, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x6c, 0x61, 0x79, 0x65,
-	0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x70,
-	0x6c, 0x61, 0x79, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x69,
-	0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x05, 0x52, 0x04, 0x70, 0x69, 0x64, 0x73, 0x22, 0x4f,
-	0x0a, 0x12, 0x53, 0x65, 0x6e, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x73, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x03, 0x6d, 0x73, 0x67, 0x12, 0x27, 0x0a, 0x06, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x6d, 0x61, 0x66, 0x69, 0x61, 0x70, 0x62,
-	0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x52, 0x06, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x22,
-	0x36, 0x0a, 0x0b, 0x45, 0x78, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x27,
-	0x0a, 0x06, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f,
-	0x2e, 0x6d, 0x61, 0x66, 0x69, 0x61, 0x70, 0x62, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x52,
-	0x06, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x22, 0x41, 0x0a, 0x16, 0x53, 0x75, 0x62, 0x73, 0x63,
-	0x72, 0x69, 0x62, 0x65, 0x54, 0x6f, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x27, 0x0a, 0x06, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x0f, 0x2e, 0x6d, 0x61, 0x66, 0x69, 0x61, 0x70, 0x62, 0x2e, 0x50, 0x6c, 0x61, 0x79,
-	0x65, 0x72, 0x52, 0x06, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x22, 0x35, 0x0a, 0x17, 0x53, 0x75,
-	0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x54, 0x6f, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x47, 0x61, 0x6d, 0x65, 0x41, 0x64, 0x64,
-	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x47, 0x61, 0x6d, 0x65, 0x41, 0x64, 0x64,
-	0x72, 0x22, 0x36, 0x0a, 0x0b, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x27, 0x0a, 0x06, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x0f, 0x2e, 0x6d, 0x61, 0x66, 0x69, 0x61, 0x70, 0x62, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65,
-	0x72, 0x52, 0x06, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x22, 0x22, 0x0a, 0x0c, 0x52, 0x6f, 0x6c,
-	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x6f, 0x6c,
-	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x22, 0x4e, 0x0a,
-	0x0b, 0x56, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x27, 0x0a, 0x06,
-	0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x6d,
-	0x61, 0x66, 0x69, 0x61, 0x70, 0x62, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x52, 0x06, 0x70,
-	0x6c, 0x61, 0x79, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x76, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x76, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x22, 0x50, 0x0a,
-	0x0b, 0x4b, 0x69, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x27, 0x0a, 0x06,
-	0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x6d,
-	0x61, 0x66, 0x69, 0x61, 0x70, 0x62, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x52, 0x06, 0x70,
-	0x6c, 0x61, 0x79, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x6b, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x6b, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x22,
-	0x53, 0x0a, 0x0c, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x22, 0x36, 0x0a, 0x0b, 0x45, 0x78, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
 	0x27, 0x0a, 0x06, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
 	0x0f, 0x2e, 0x6d, 0x61, 0x66, 0x69, 0x61, 0x70, 0x62, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72,
-	0x52, 0x06, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x68, 0x65, 0x63,
-	0x6b, 0x69, 0x6e, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x63, 0x68, 0x65, 0x63,
-	0x6b, 0x69, 0x6e, 0x67, 0x22, 0x23, 0x0a, 0x0d, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x22, 0x0b, 0x0a, 0x09, 0x44, 0x61, 0x79,
-	0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x22, 0x26, 0x0a, 0x0c, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72,
-	0x4b, 0x69, 0x6c, 0x6c, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x22, 0x26,
-	0x0a, 0x0c, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x4a, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x12, 0x16,
-	0x0a, 0x06, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
-	0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x22, 0x54, 0x0a, 0x07, 0x47, 0x61, 0x6d, 0x65, 0x45, 0x6e,
-	0x64, 0x12, 0x10, 0x0a, 0x03, 0x77, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
-	0x77, 0x6f, 0x6e, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x6e, 0x61,
-	0x6d, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x6c, 0x61, 0x79, 0x65,
-	0x72, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x18,
-	0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x22, 0x09, 0x0a, 0x07,
-	0x59, 0x6f, 0x75, 0x44, 0x65, 0x61, 0x64, 0x22, 0x80, 0x02, 0x0a, 0x09, 0x47, 0x61, 0x6d, 0x65,
-	0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x26, 0x0a, 0x03, 0x64, 0x61, 0x79,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6d, 0x61, 0x66, 0x69, 0x61, 0x70, 0x62,
-	0x2e, 0x44, 0x61, 0x79, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x48, 0x00, 0x52, 0x03, 0x64, 0x61,
-	0x79, 0x12, 0x2f, 0x0a, 0x06, 0x6b, 0x69, 0x6c, 0x6c, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x52, 0x06, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x22, 0x41, 0x0a, 0x16, 0x53, 0x75, 0x62, 0x73,
+	0x63, 0x72, 0x69, 0x62, 0x65, 0x54, 0x6f, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x27, 0x0a, 0x06, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x6d, 0x61, 0x66, 0x69, 0x61, 0x70, 0x62, 0x2e, 0x50, 0x6c, 0x61,
+	0x79, 0x65, 0x72, 0x52, 0x06, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x22, 0x4e, 0x0a, 0x17, 0x53,
+	0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x54, 0x6f, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x47, 0x61, 0x6d, 0x65, 0x41, 0x64,
+	0x64, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x47, 0x61, 0x6d, 0x65, 0x41, 0x64,
+	0x64, 0x72, 0x12, 0x17, 0x0a, 0x07, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x67, 0x61, 0x6d, 0x65, 0x49, 0x64, 0x22, 0x36, 0x0a, 0x0b, 0x52,
+	0x6f, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x27, 0x0a, 0x06, 0x70, 0x6c,
+	0x61, 0x79, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x6d, 0x61, 0x66,
+	0x69, 0x61, 0x70, 0x62, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x52, 0x06, 0x70, 0x6c, 0x61,
+	0x79, 0x65, 0x72, 0x22, 0x49, 0x0a, 0x0c, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x12, 0x25, 0x0a, 0x04, 0x69, 0x6e, 0x66, 0x6f, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x6d, 0x61, 0x66, 0x69, 0x61, 0x70, 0x62, 0x2e,
+	0x52, 0x6f, 0x6c, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x04, 0x69, 0x6e, 0x66, 0x6f, 0x22, 0xe0,
+	0x01, 0x0a, 0x08, 0x52, 0x6f, 0x6c, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74,
+	0x69, 0x74, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c,
+	0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x61, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x74, 0x65, 0x61, 0x6d, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x65, 0x61, 0x6d, 0x5f, 0x74, 0x69,
+	0x74, 0x6c, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x65, 0x61, 0x6d, 0x54,
+	0x69, 0x74, 0x6c, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x12, 0x21, 0x0a, 0x0c, 0x6e, 0x69,
+	0x67, 0x68, 0x74, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x6e, 0x69, 0x67, 0x68, 0x74, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x0a,
+	0x0a, 0x6e, 0x69, 0x67, 0x68, 0x74, 0x5f, 0x68, 0x69, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x6e, 0x69, 0x67, 0x68, 0x74, 0x48, 0x69, 0x6e, 0x74, 0x12, 0x23, 0x0a, 0x0d,
+	0x6e, 0x69, 0x67, 0x68, 0x74, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x08, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x0c, 0x6e, 0x69, 0x67, 0x68, 0x74, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x22, 0x3b, 0x0a, 0x10, 0x52, 0x6f, 0x6c, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x27, 0x0a, 0x05, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x6d, 0x61, 0x66, 0x69, 0x61, 0x70, 0x62, 0x2e, 0x52,
+	0x6f, 0x6c, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x05, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x22, 0xd0,
+	0x01, 0x0a, 0x0a, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a,
+	0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69,
+	0x74, 0x6c, 0x65, 0x12, 0x22, 0x0a, 0x03, 0x61, 0x72, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x10, 0x2e, 0x6d, 0x61, 0x66, 0x69, 0x61, 0x70, 0x62, 0x2e, 0x41, 0x72, 0x67, 0x54, 0x79,
+	0x70, 0x65, 0x52, 0x03, 0x61, 0x72, 0x67, 0x12, 0x18, 0x0a, 0x07, 0x74, 0x61, 0x72, 0x67, 0x65,
+	0x74, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x05, 0x52, 0x07, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74,
+	0x73, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x61, 0x6e, 0x5f, 0x73, 0x6b, 0x69, 0x70, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x07, 0x63, 0x61, 0x6e, 0x53, 0x6b, 0x69, 0x70, 0x12, 0x22, 0x0a, 0x0c,
+	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0c, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x61, 0x78, 0x5f, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x73, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x6d, 0x61, 0x78, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74,
+	0x73, 0x22, 0x76, 0x0a, 0x14, 0x50, 0x65, 0x72, 0x66, 0x6f, 0x72, 0x6d, 0x41, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x27, 0x0a, 0x06, 0x70, 0x6c, 0x61,
+	0x79, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x6d, 0x61, 0x66, 0x69,
+	0x61, 0x70, 0x62, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x52, 0x06, 0x70, 0x6c, 0x61, 0x79,
+	0x65, 0x72, 0x12, 0x1b, 0x0a, 0x09, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12,
+	0x18, 0x0a, 0x07, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x05,
+	0x52, 0x07, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x73, 0x22, 0xb9, 0x01, 0x0a, 0x15, 0x50, 0x65,
+	0x72, 0x66, 0x6f, 0x72, 0x6d, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64,
+	0x12, 0x30, 0x0a, 0x07, 0x6f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x16, 0x2e, 0x6d, 0x61, 0x66, 0x69, 0x61, 0x70, 0x62, 0x2e, 0x41, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x4f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x52, 0x07, 0x6f, 0x75, 0x74, 0x63, 0x6f,
+	0x6d, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72,
+	0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2d, 0x0a, 0x07, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x6d, 0x61, 0x66, 0x69, 0x61, 0x70,
+	0x62, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x07, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x6f, 0x0a, 0x18, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62,
+	0x6c, 0x65, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x24, 0x0a, 0x05, 0x70, 0x68, 0x61, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x0e, 0x2e, 0x6d, 0x61, 0x66, 0x69, 0x61, 0x70, 0x62, 0x2e, 0x50, 0x68, 0x61, 0x73, 0x65,
+	0x52, 0x05, 0x70, 0x68, 0x61, 0x73, 0x65, 0x12, 0x2d, 0x0a, 0x07, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x6d, 0x61, 0x66, 0x69, 0x61,
+	0x70, 0x62, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x07, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x4e, 0x0a, 0x0b, 0x56, 0x6f, 0x74, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x27, 0x0a, 0x06, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x6d, 0x61, 0x66, 0x69, 0x61, 0x70, 0x62, 0x2e,
+	0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x52, 0x06, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x12, 0x16,
+	0x0a, 0x06, 0x76, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06,
+	0x76, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x22, 0x50, 0x0a, 0x0b, 0x4b, 0x69, 0x6c, 0x6c, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x27, 0x0a, 0x06, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x6d, 0x61, 0x66, 0x69, 0x61, 0x70, 0x62, 0x2e,
+	0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x52, 0x06, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x12, 0x18,
+	0x0a, 0x07, 0x6b, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x07, 0x6b, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x22, 0x53, 0x0a, 0x0c, 0x43, 0x68, 0x65, 0x63,
+	0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x27, 0x0a, 0x06, 0x70, 0x6c, 0x61, 0x79,
+	0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x6d, 0x61, 0x66, 0x69, 0x61,
+	0x70, 0x62, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x52, 0x06, 0x70, 0x6c, 0x61, 0x79, 0x65,
+	0x72, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x08, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x22, 0x23, 0x0a,
+	0x0d, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12,
+	0x0a, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x72, 0x6f,
+	0x6c, 0x65, 0x22, 0x7e, 0x0a, 0x0c, 0x50, 0x68, 0x61, 0x73, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x64, 0x12, 0x24, 0x0a, 0x05, 0x70, 0x68, 0x61, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x0e, 0x2e, 0x6d, 0x61, 0x66, 0x69, 0x61, 0x70, 0x62, 0x2e, 0x50, 0x68, 0x61, 0x73,
+	0x65, 0x52, 0x05, 0x70, 0x68, 0x61, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x64, 0x61, 0x79, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x64, 0x61, 0x79, 0x12, 0x36, 0x0a, 0x08, 0x64, 0x65,
+	0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x08, 0x64, 0x65, 0x61, 0x64, 0x6c, 0x69,
+	0x6e, 0x65, 0x22, 0x26, 0x0a, 0x0c, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x4b, 0x69, 0x6c, 0x6c,
+	0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x22, 0x26, 0x0a, 0x0c, 0x50, 0x6c,
+	0x61, 0x79, 0x65, 0x72, 0x4a, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x6c,
+	0x61, 0x79, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x6c, 0x61, 0x79,
+	0x65, 0x72, 0x22, 0x54, 0x0a, 0x07, 0x47, 0x61, 0x6d, 0x65, 0x45, 0x6e, 0x64, 0x12, 0x10, 0x0a,
+	0x03, 0x77, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x77, 0x6f, 0x6e, 0x12,
+	0x21, 0x0a, 0x0c, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x4e, 0x61, 0x6d,
+	0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x05, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x22, 0x09, 0x0a, 0x07, 0x59, 0x6f, 0x75, 0x44,
+	0x65, 0x61, 0x64, 0x22, 0x34, 0x0a, 0x08, 0x56, 0x6f, 0x74, 0x65, 0x54, 0x75, 0x72, 0x6e, 0x12,
+	0x10, 0x0a, 0x03, 0x70, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x70, 0x69,
+	0x64, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x22, 0x6e, 0x0a, 0x0a, 0x53, 0x70, 0x65,
+	0x65, 0x63, 0x68, 0x54, 0x75, 0x72, 0x6e, 0x12, 0x10, 0x0a, 0x03, 0x70, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x70, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x6c, 0x61,
+	0x79, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x6c, 0x61, 0x79, 0x65,
+	0x72, 0x12, 0x36, 0x0a, 0x08, 0x64, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x08, 0x64, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x22, 0x35, 0x0a, 0x0e, 0x53, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x53, 0x68, 0x75, 0x74, 0x64, 0x6f, 0x77, 0x6e, 0x12, 0x23, 0x0a, 0x0d, 0x64,
+	0x72, 0x61, 0x69, 0x6e, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x0c, 0x64, 0x72, 0x61, 0x69, 0x6e, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73,
+	0x22, 0xf3, 0x04, 0x0a, 0x09, 0x47, 0x61, 0x6d, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x18,
+	0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x26, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x6d, 0x61, 0x66, 0x69, 0x61, 0x70, 0x62,
+	0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65,
+	0x12, 0x10, 0x0a, 0x03, 0x73, 0x65, 0x71, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x04, 0x52, 0x03, 0x73,
+	0x65, 0x71, 0x12, 0x2e, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x04, 0x74, 0x69,
+	0x6d, 0x65, 0x12, 0x3c, 0x0a, 0x0d, 0x70, 0x68, 0x61, 0x73, 0x65, 0x5f, 0x63, 0x68, 0x61, 0x6e,
+	0x67, 0x65, 0x64, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6d, 0x61, 0x66, 0x69,
+	0x61, 0x70, 0x62, 0x2e, 0x50, 0x68, 0x61, 0x73, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64,
+	0x48, 0x00, 0x52, 0x0c, 0x70, 0x68, 0x61, 0x73, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64,
+	0x12, 0x2f, 0x0a, 0x06, 0x6b, 0x69, 0x6c, 0x6c, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x15, 0x2e, 0x6d, 0x61, 0x66, 0x69, 0x61, 0x70, 0x62, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65,
+	0x72, 0x4b, 0x69, 0x6c, 0x6c, 0x65, 0x64, 0x48, 0x00, 0x52, 0x06, 0x6b, 0x69, 0x6c, 0x6c, 0x65,
+	0x64, 0x12, 0x2f, 0x0a, 0x06, 0x6a, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28,
 	0x0b, 0x32, 0x15, 0x2e, 0x6d, 0x61, 0x66, 0x69, 0x61, 0x70, 0x62, 0x2e, 0x50, 0x6c, 0x61, 0x79,
-	0x65, 0x72, 0x4b, 0x69, 0x6c, 0x6c, 0x65, 0x64, 0x48, 0x00, 0x52, 0x06, 0x6b, 0x69, 0x6c, 0x6c,
-	0x65, 0x64, 0x12, 0x2f, 0x0a, 0x06, 0x6a, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6d, 0x61, 0x66, 0x69, 0x61, 0x70, 0x62, 0x2e, 0x50, 0x6c, 0x61,
-	0x79, 0x65, 0x72, 0x4a, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x48, 0x00, 0x52, 0x06, 0x6a, 0x61, 0x69,
-	0x6c, 0x65, 0x64, 0x12, 0x24, 0x0a, 0x03, 0x65, 0x6e, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x10, 0x2e, 0x6d, 0x61, 0x66, 0x69, 0x61, 0x70, 0x62, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x45,
-	0x6e, 0x64, 0x48, 0x00, 0x52, 0x03, 0x65, 0x6e, 0x64, 0x12, 0x26, 0x0a, 0x04, 0x64, 0x65, 0x61,
-	0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x6d, 0x61, 0x66, 0x69, 0x61, 0x70,
-	0x62, 0x2e, 0x59, 0x6f, 0x75, 0x44, 0x65, 0x61, 0x64, 0x48, 0x00, 0x52, 0x04, 0x64, 0x65, 0x61,
-	0x64, 0x42, 0x07, 0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x32, 0x9f, 0x02, 0x0a, 0x05, 0x4c,
-	0x6f, 0x62, 0x62, 0x79, 0x12, 0x2c, 0x0a, 0x04, 0x4a, 0x6f, 0x69, 0x6e, 0x12, 0x14, 0x2e, 0x6d,
-	0x61, 0x66, 0x69, 0x61, 0x70, 0x62, 0x2e, 0x4a, 0x6f, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x65, 0x72, 0x4a, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x48, 0x00, 0x52, 0x06, 0x6a, 0x61, 0x69, 0x6c,
+	0x65, 0x64, 0x12, 0x24, 0x0a, 0x03, 0x65, 0x6e, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x10, 0x2e, 0x6d, 0x61, 0x66, 0x69, 0x61, 0x70, 0x62, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x45, 0x6e,
+	0x64, 0x48, 0x00, 0x52, 0x03, 0x65, 0x6e, 0x64, 0x12, 0x26, 0x0a, 0x04, 0x64, 0x65, 0x61, 0x64,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x6d, 0x61, 0x66, 0x69, 0x61, 0x70, 0x62,
+	0x2e, 0x59, 0x6f, 0x75, 0x44, 0x65, 0x61, 0x64, 0x48, 0x00, 0x52, 0x04, 0x64, 0x65, 0x61, 0x64,
+	0x12, 0x35, 0x0a, 0x08, 0x73, 0x68, 0x75, 0x74, 0x64, 0x6f, 0x77, 0x6e, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6d, 0x61, 0x66, 0x69, 0x61, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x53, 0x68, 0x75, 0x74, 0x64, 0x6f, 0x77, 0x6e, 0x48, 0x00, 0x52, 0x08, 0x73,
+	0x68, 0x75, 0x74, 0x64, 0x6f, 0x77, 0x6e, 0x12, 0x39, 0x0a, 0x0c, 0x63, 0x68, 0x65, 0x63, 0x6b,
+	0x5f, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e,
+	0x6d, 0x61, 0x66, 0x69, 0x61, 0x70, 0x62, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x48, 0x00, 0x52, 0x0b, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x12, 0x30, 0x0a, 0x09, 0x76, 0x6f, 0x74, 0x65, 0x5f, 0x74, 0x75, 0x72, 0x6e, 0x18,
+	0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x6d, 0x61, 0x66, 0x69, 0x61, 0x70, 0x62, 0x2e,
+	0x56, 0x6f, 0x74, 0x65, 0x54, 0x75, 0x72, 0x6e, 0x48, 0x00, 0x52, 0x08, 0x76, 0x6f, 0x74, 0x65,
+	0x54, 0x75, 0x72, 0x6e, 0x12, 0x36, 0x0a, 0x0b, 0x73, 0x70, 0x65, 0x65, 0x63, 0x68, 0x5f, 0x74,
+	0x75, 0x72, 0x6e, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x6d, 0x61, 0x66, 0x69,
+	0x61, 0x70, 0x62, 0x2e, 0x53, 0x70, 0x65, 0x65, 0x63, 0x68, 0x54, 0x75, 0x72, 0x6e, 0x48, 0x00,
+	0x52, 0x0a, 0x73, 0x70, 0x65, 0x65, 0x63, 0x68, 0x54, 0x75, 0x72, 0x6e, 0x42, 0x07, 0x0a, 0x05,
+	0x65, 0x76, 0x65, 0x6e, 0x74, 0x4a, 0x04, 0x08, 0x01, 0x10, 0x02, 0x4a, 0x04, 0x08, 0x02, 0x10,
+	0x03, 0x52, 0x03, 0x64, 0x61, 0x79, 0x22, 0x37, 0x0a, 0x0c, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x27, 0x0a, 0x06, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x6d, 0x61, 0x66, 0x69, 0x61, 0x70, 0x62,
+	0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x52, 0x06, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x22,
+	0x8a, 0x01, 0x0a, 0x04, 0x53, 0x65, 0x61, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x70, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x70, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14,
+	0x0a, 0x05, 0x61, 0x6c, 0x69, 0x76, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x61,
+	0x6c, 0x69, 0x76, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x65,
+	0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74,
+	0x65, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x6f, 0x75, 0x6c, 0x73, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x66, 0x6f, 0x75, 0x6c, 0x73, 0x22, 0x5d, 0x0a, 0x0b,
+	0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x64,
+	0x61, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x64, 0x61, 0x79, 0x12, 0x10, 0x0a,
+	0x03, 0x70, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x70, 0x69, 0x64, 0x12,
+	0x12, 0x0a, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x72,
+	0x6f, 0x6c, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xfa, 0x03, 0x0a, 0x11,
+	0x47, 0x61, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x24, 0x0a, 0x05, 0x70, 0x68, 0x61, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x0e, 0x2e, 0x6d, 0x61, 0x66, 0x69, 0x61, 0x70, 0x62, 0x2e, 0x50, 0x68, 0x61, 0x73, 0x65,
+	0x52, 0x05, 0x70, 0x68, 0x61, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x64, 0x61, 0x79, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x64, 0x61, 0x79, 0x12, 0x36, 0x0a, 0x08, 0x64, 0x65, 0x61,
+	0x64, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x08, 0x64, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e,
+	0x65, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x61, 0x75, 0x73, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x06, 0x70, 0x61, 0x75, 0x73, 0x65, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6e, 0x64,
+	0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x12,
+	0x23, 0x0a, 0x05, 0x73, 0x65, 0x61, 0x74, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d,
+	0x2e, 0x6d, 0x61, 0x66, 0x69, 0x61, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x61, 0x74, 0x52, 0x05, 0x73,
+	0x65, 0x61, 0x74, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x70, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x03, 0x70, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x6c,
+	0x69, 0x76, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x61, 0x6c, 0x69, 0x76, 0x65,
+	0x12, 0x2c, 0x0a, 0x06, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x14, 0x2e, 0x6d, 0x61, 0x66, 0x69, 0x61, 0x70, 0x62, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b,
+	0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x06, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x12, 0x14,
+	0x0a, 0x05, 0x76, 0x6f, 0x74, 0x65, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x05, 0x52, 0x05, 0x76,
+	0x6f, 0x74, 0x65, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x76, 0x6f, 0x74, 0x65, 0x73, 0x5f, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x05, 0x52, 0x0a, 0x76, 0x6f, 0x74, 0x65, 0x73,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x73, 0x65,
+	0x71, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x6c, 0x61, 0x73, 0x74, 0x53, 0x65, 0x71,
+	0x12, 0x1a, 0x0a, 0x08, 0x73, 0x70, 0x65, 0x61, 0x6b, 0x65, 0x72, 0x73, 0x18, 0x0e, 0x20, 0x03,
+	0x28, 0x05, 0x52, 0x08, 0x73, 0x70, 0x65, 0x61, 0x6b, 0x65, 0x72, 0x73, 0x12, 0x1a, 0x0a, 0x08,
+	0x6e, 0x6f, 0x6d, 0x69, 0x6e, 0x65, 0x65, 0x73, 0x18, 0x0f, 0x20, 0x03, 0x28, 0x05, 0x52, 0x08,
+	0x6e, 0x6f, 0x6d, 0x69, 0x6e, 0x65, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x6f, 0x74, 0x65,
+	0x72, 0x18, 0x10, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x76, 0x6f, 0x74, 0x65, 0x72, 0x12, 0x18,
+	0x0a, 0x07, 0x73, 0x70, 0x65, 0x61, 0x6b, 0x65, 0x72, 0x18, 0x11, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x07, 0x73, 0x70, 0x65, 0x61, 0x6b, 0x65, 0x72, 0x22, 0x4a, 0x0a, 0x09, 0x4c, 0x6f, 0x62, 0x62,
+	0x79, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f,
+	0x6e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x6c, 0x61,
+	0x79, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61, 0x70, 0x61,
+	0x63, 0x69, 0x74, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x63, 0x61, 0x70, 0x61,
+	0x63, 0x69, 0x74, 0x79, 0x22, 0x7f, 0x0a, 0x08, 0x47, 0x61, 0x6d, 0x65, 0x49, 0x6e, 0x66, 0x6f,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64,
+	0x12, 0x12, 0x0a, 0x04, 0x61, 0x64, 0x64, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x61, 0x64, 0x64, 0x72, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x6e,
+	0x61, 0x6d, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x6c, 0x61, 0x79,
+	0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x6c, 0x69, 0x76, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x61, 0x6c, 0x69, 0x76, 0x65, 0x12, 0x16, 0x0a,
+	0x06, 0x70, 0x61, 0x75, 0x73, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x70,
+	0x61, 0x75, 0x73, 0x65, 0x64, 0x22, 0x6a, 0x0a, 0x11, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4c, 0x69,
+	0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x07, 0x6c, 0x6f,
+	0x62, 0x62, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6d, 0x61,
+	0x66, 0x69, 0x61, 0x70, 0x62, 0x2e, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x49, 0x6e, 0x66, 0x6f, 0x52,
+	0x07, 0x6c, 0x6f, 0x62, 0x62, 0x69, 0x65, 0x73, 0x12, 0x27, 0x0a, 0x05, 0x67, 0x61, 0x6d, 0x65,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x6d, 0x61, 0x66, 0x69, 0x61, 0x70,
+	0x62, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x05, 0x67, 0x61, 0x6d, 0x65,
+	0x73, 0x22, 0x28, 0x0a, 0x0d, 0x47, 0x61, 0x6d, 0x65, 0x49, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x67, 0x61, 0x6d, 0x65, 0x49, 0x64, 0x22, 0x88, 0x01, 0x0a, 0x0a,
+	0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12,
+	0x0a, 0x04, 0x61, 0x64, 0x64, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x61, 0x64,
+	0x64, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x6c, 0x69, 0x76, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x61, 0x6c, 0x69, 0x76, 0x65, 0x12, 0x12, 0x0a, 0x04,
+	0x76, 0x6f, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x76, 0x6f, 0x74, 0x65,
+	0x12, 0x14, 0x0a, 0x05, 0x66, 0x6f, 0x75, 0x6c, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x05, 0x66, 0x6f, 0x75, 0x6c, 0x73, 0x22, 0x9e, 0x01, 0x0a, 0x0e, 0x41, 0x64, 0x6d, 0x69, 0x6e,
+	0x47, 0x61, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x68, 0x61,
+	0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x70, 0x68, 0x61, 0x73, 0x65, 0x12,
+	0x16, 0x0a, 0x06, 0x70, 0x61, 0x75, 0x73, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x06, 0x70, 0x61, 0x75, 0x73, 0x65, 0x64, 0x12, 0x2d, 0x0a, 0x07, 0x70, 0x6c, 0x61, 0x79, 0x65,
+	0x72, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x6d, 0x61, 0x66, 0x69, 0x61,
+	0x70, 0x62, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x07, 0x70,
+	0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x76, 0x6f, 0x74, 0x65, 0x73, 0x5f,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x03, 0x28, 0x05, 0x52, 0x0a, 0x76, 0x6f, 0x74,
+	0x65, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x43, 0x0a, 0x10, 0x50, 0x61, 0x75, 0x73, 0x65,
+	0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x67,
+	0x61, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x67, 0x61,
+	0x6d, 0x65, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x61, 0x75, 0x73, 0x65, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x70, 0x61, 0x75, 0x73, 0x65, 0x64, 0x22, 0x33, 0x0a, 0x0b,
+	0x4b, 0x69, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x10, 0x0a, 0x03, 0x62, 0x61, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x03, 0x62, 0x61,
+	0x6e, 0x22, 0x21, 0x0a, 0x0b, 0x46, 0x6f, 0x75, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x22, 0x23, 0x0a, 0x0f, 0x41, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x73, 0x67, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6d, 0x73, 0x67, 0x2a, 0x31, 0x0a, 0x07, 0x41, 0x72, 0x67,
+	0x54, 0x79, 0x70, 0x65, 0x12, 0x11, 0x0a, 0x0d, 0x41, 0x52, 0x47, 0x5f, 0x54, 0x59, 0x50, 0x45,
+	0x5f, 0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x00, 0x12, 0x13, 0x0a, 0x0f, 0x41, 0x52, 0x47, 0x5f, 0x54,
+	0x59, 0x50, 0x45, 0x5f, 0x50, 0x4c, 0x41, 0x59, 0x45, 0x52, 0x10, 0x01, 0x2a, 0x69, 0x0a, 0x0d,
+	0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x12, 0x1e, 0x0a,
+	0x1a, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4f, 0x55, 0x54, 0x43, 0x4f, 0x4d, 0x45, 0x5f,
+	0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1a, 0x0a,
+	0x16, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4f, 0x55, 0x54, 0x43, 0x4f, 0x4d, 0x45, 0x5f,
+	0x41, 0x50, 0x50, 0x4c, 0x49, 0x45, 0x44, 0x10, 0x01, 0x12, 0x1c, 0x0a, 0x18, 0x41, 0x43, 0x54,
+	0x49, 0x4f, 0x4e, 0x5f, 0x4f, 0x55, 0x54, 0x43, 0x4f, 0x4d, 0x45, 0x5f, 0x53, 0x43, 0x48, 0x45,
+	0x44, 0x55, 0x4c, 0x45, 0x44, 0x10, 0x02, 0x2a, 0xc7, 0x04, 0x0a, 0x0b, 0x45, 0x72, 0x72, 0x6f,
+	0x72, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x18, 0x45, 0x52, 0x52, 0x4f, 0x52,
+	0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46,
+	0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1c, 0x0a, 0x18, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x52,
+	0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x42, 0x41, 0x44, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x45, 0x53,
+	0x54, 0x10, 0x01, 0x12, 0x1f, 0x0a, 0x1b, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x52, 0x45, 0x41,
+	0x53, 0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x5f, 0x50, 0x4c, 0x41, 0x59,
+	0x45, 0x52, 0x10, 0x02, 0x12, 0x1c, 0x0a, 0x18, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x52, 0x45,
+	0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x44, 0x45, 0x41, 0x44, 0x5f, 0x50, 0x4c, 0x41, 0x59, 0x45, 0x52,
+	0x10, 0x03, 0x12, 0x1b, 0x0a, 0x17, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x52, 0x45, 0x41, 0x53,
+	0x4f, 0x4e, 0x5f, 0x57, 0x52, 0x4f, 0x4e, 0x47, 0x5f, 0x52, 0x4f, 0x4c, 0x45, 0x10, 0x04, 0x12,
+	0x1c, 0x0a, 0x18, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f,
+	0x57, 0x52, 0x4f, 0x4e, 0x47, 0x5f, 0x50, 0x48, 0x41, 0x53, 0x45, 0x10, 0x05, 0x12, 0x1f, 0x0a,
+	0x1b, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x55, 0x4e,
+	0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x06, 0x12, 0x1d,
+	0x0a, 0x19, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x57,
+	0x52, 0x4f, 0x4e, 0x47, 0x5f, 0x54, 0x41, 0x52, 0x47, 0x45, 0x54, 0x10, 0x07, 0x12, 0x1c, 0x0a,
+	0x18, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x54, 0x41,
+	0x52, 0x47, 0x45, 0x54, 0x5f, 0x44, 0x45, 0x41, 0x44, 0x10, 0x08, 0x12, 0x1e, 0x0a, 0x1a, 0x45,
+	0x52, 0x52, 0x4f, 0x52, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x41, 0x4c, 0x52, 0x45,
+	0x41, 0x44, 0x59, 0x5f, 0x41, 0x43, 0x54, 0x45, 0x44, 0x10, 0x09, 0x12, 0x1c, 0x0a, 0x18, 0x45,
+	0x52, 0x52, 0x4f, 0x52, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x47, 0x41, 0x4d, 0x45,
+	0x5f, 0x50, 0x41, 0x55, 0x53, 0x45, 0x44, 0x10, 0x0a, 0x12, 0x1a, 0x0a, 0x16, 0x45, 0x52, 0x52,
+	0x4f, 0x52, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x47, 0x41, 0x4d, 0x45, 0x5f, 0x4f,
+	0x56, 0x45, 0x52, 0x10, 0x0b, 0x12, 0x1b, 0x0a, 0x17, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x52,
+	0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x4e, 0x41, 0x4d, 0x45, 0x5f, 0x54, 0x41, 0x4b, 0x45, 0x4e,
+	0x10, 0x0c, 0x12, 0x17, 0x0a, 0x13, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x52, 0x45, 0x41, 0x53,
+	0x4f, 0x4e, 0x5f, 0x42, 0x41, 0x4e, 0x4e, 0x45, 0x44, 0x10, 0x0d, 0x12, 0x1e, 0x0a, 0x1a, 0x45,
+	0x52, 0x52, 0x4f, 0x52, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x53, 0x48, 0x55, 0x54,
+	0x54, 0x49, 0x4e, 0x47, 0x5f, 0x44, 0x4f, 0x57, 0x4e, 0x10, 0x0e, 0x12, 0x19, 0x0a, 0x15, 0x45,
+	0x52, 0x52, 0x4f, 0x52, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x54, 0x4f, 0x4f, 0x5f,
+	0x53, 0x4c, 0x4f, 0x57, 0x10, 0x0f, 0x12, 0x1e, 0x0a, 0x1a, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f,
+	0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x59, 0x4f, 0x55, 0x52, 0x5f,
+	0x54, 0x55, 0x52, 0x4e, 0x10, 0x10, 0x12, 0x1d, 0x0a, 0x19, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f,
+	0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x5f, 0x47,
+	0x41, 0x4d, 0x45, 0x10, 0x11, 0x12, 0x1a, 0x0a, 0x16, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x52,
+	0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x42, 0x41, 0x44, 0x5f, 0x54, 0x4f, 0x4b, 0x45, 0x4e, 0x10,
+	0x12, 0x2a, 0xa6, 0x02, 0x0a, 0x09, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12,
+	0x1a, 0x0a, 0x16, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e,
+	0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1c, 0x0a, 0x18, 0x45,
+	0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x50, 0x48, 0x41, 0x53, 0x45, 0x5f,
+	0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x44, 0x10, 0x01, 0x12, 0x1c, 0x0a, 0x18, 0x45, 0x56, 0x45,
+	0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x50, 0x4c, 0x41, 0x59, 0x45, 0x52, 0x5f, 0x4b,
+	0x49, 0x4c, 0x4c, 0x45, 0x44, 0x10, 0x02, 0x12, 0x1c, 0x0a, 0x18, 0x45, 0x56, 0x45, 0x4e, 0x54,
+	0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x50, 0x4c, 0x41, 0x59, 0x45, 0x52, 0x5f, 0x4a, 0x41, 0x49,
+	0x4c, 0x45, 0x44, 0x10, 0x03, 0x12, 0x17, 0x0a, 0x13, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54,
+	0x59, 0x50, 0x45, 0x5f, 0x47, 0x41, 0x4d, 0x45, 0x5f, 0x45, 0x4e, 0x44, 0x10, 0x04, 0x12, 0x17,
+	0x0a, 0x13, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x59, 0x4f, 0x55,
+	0x5f, 0x44, 0x45, 0x41, 0x44, 0x10, 0x05, 0x12, 0x1e, 0x0a, 0x1a, 0x45, 0x56, 0x45, 0x4e, 0x54,
+	0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x53, 0x45, 0x52, 0x56, 0x45, 0x52, 0x5f, 0x53, 0x48, 0x55,
+	0x54, 0x44, 0x4f, 0x57, 0x4e, 0x10, 0x06, 0x12, 0x1b, 0x0a, 0x17, 0x45, 0x56, 0x45, 0x4e, 0x54,
+	0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x43, 0x48, 0x45, 0x43, 0x4b, 0x5f, 0x52, 0x45, 0x53, 0x55,
+	0x4c, 0x54, 0x10, 0x07, 0x12, 0x18, 0x0a, 0x14, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59,
+	0x50, 0x45, 0x5f, 0x56, 0x4f, 0x54, 0x45, 0x5f, 0x54, 0x55, 0x52, 0x4e, 0x10, 0x08, 0x12, 0x1a,
+	0x0a, 0x16, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x53, 0x50, 0x45,
+	0x45, 0x43, 0x48, 0x5f, 0x54, 0x55, 0x52, 0x4e, 0x10, 0x09, 0x2a, 0x79, 0x0a, 0x05, 0x50, 0x68,
+	0x61, 0x73, 0x65, 0x12, 0x15, 0x0a, 0x11, 0x50, 0x48, 0x41, 0x53, 0x45, 0x5f, 0x55, 0x4e, 0x53,
+	0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0d, 0x0a, 0x09, 0x50, 0x48,
+	0x41, 0x53, 0x45, 0x5f, 0x44, 0x41, 0x59, 0x10, 0x01, 0x12, 0x0f, 0x0a, 0x0b, 0x50, 0x48, 0x41,
+	0x53, 0x45, 0x5f, 0x4e, 0x49, 0x47, 0x48, 0x54, 0x10, 0x02, 0x12, 0x10, 0x0a, 0x0c, 0x50, 0x48,
+	0x41, 0x53, 0x45, 0x5f, 0x56, 0x4f, 0x54, 0x49, 0x4e, 0x47, 0x10, 0x03, 0x12, 0x14, 0x0a, 0x10,
+	0x50, 0x48, 0x41, 0x53, 0x45, 0x5f, 0x4c, 0x41, 0x53, 0x54, 0x5f, 0x57, 0x4f, 0x52, 0x44, 0x53,
+	0x10, 0x04, 0x12, 0x11, 0x0a, 0x0d, 0x50, 0x48, 0x41, 0x53, 0x45, 0x5f, 0x4d, 0x45, 0x45, 0x54,
+	0x49, 0x4e, 0x47, 0x10, 0x05, 0x32, 0xb0, 0x02, 0x0a, 0x05, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x12,
+	0x2c, 0x0a, 0x04, 0x4a, 0x6f, 0x69, 0x6e, 0x12, 0x14, 0x2e, 0x6d, 0x61, 0x66, 0x69, 0x61, 0x70,
+	0x62, 0x2e, 0x4a, 0x6f, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e,
+	0x6d, 0x61, 0x66, 0x69, 0x61, 0x70, 0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x39, 0x0a,
+	0x0a, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x0e, 0x2e, 0x6d, 0x61,
+	0x66, 0x69, 0x61, 0x70, 0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x1b, 0x2e, 0x6d, 0x61,
+	0x66, 0x69, 0x61, 0x70, 0x62, 0x2e, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3a, 0x0a, 0x0b, 0x53, 0x65, 0x6e, 0x64,
+	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1b, 0x2e, 0x6d, 0x61, 0x66, 0x69, 0x61, 0x70,
+	0x62, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x6d, 0x61, 0x66, 0x69, 0x61, 0x70, 0x62, 0x2e, 0x45,
+	0x6d, 0x70, 0x74, 0x79, 0x12, 0x2c, 0x0a, 0x04, 0x45, 0x78, 0x69, 0x74, 0x12, 0x14, 0x2e, 0x6d,
+	0x61, 0x66, 0x69, 0x61, 0x70, 0x62, 0x2e, 0x45, 0x78, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
 	0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x6d, 0x61, 0x66, 0x69, 0x61, 0x70, 0x62, 0x2e, 0x45, 0x6d, 0x70,
-	0x74, 0x79, 0x12, 0x39, 0x0a, 0x0a, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74,
-	0x12, 0x0e, 0x2e, 0x6d, 0x61, 0x66, 0x69, 0x61, 0x70, 0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
-	0x1a, 0x1b, 0x2e, 0x6d, 0x61, 0x66, 0x69, 0x61, 0x70, 0x62, 0x2e, 0x4d, 0x65, 0x6d, 0x62, 0x65,
-	0x72, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3a, 0x0a,
-	0x0b, 0x53, 0x65, 0x6e, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1b, 0x2e, 0x6d,
-	0x61, 0x66, 0x69, 0x61, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x6d, 0x61, 0x66, 0x69,
-	0x61, 0x70, 0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x2c, 0x0a, 0x04, 0x45, 0x78, 0x69,
-	0x74, 0x12, 0x14, 0x2e, 0x6d, 0x61, 0x66, 0x69, 0x61, 0x70, 0x62, 0x2e, 0x45, 0x78, 0x69, 0x74,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x6d, 0x61, 0x66, 0x69, 0x61, 0x70,
-	0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x43, 0x0a, 0x0f, 0x53, 0x75, 0x62, 0x73, 0x63,
-	0x72, 0x69, 0x62, 0x65, 0x54, 0x6f, 0x47, 0x61, 0x6d, 0x65, 0x12, 0x0e, 0x2e, 0x6d, 0x61, 0x66,
-	0x69, 0x61, 0x70, 0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x20, 0x2e, 0x6d, 0x61, 0x66,
-	0x69, 0x61, 0x70, 0x62, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x54, 0x6f,
-	0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0xfc, 0x03, 0x0a,
-	0x04, 0x47, 0x61, 0x6d, 0x65, 0x12, 0x39, 0x0a, 0x0a, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x4c,
-	0x69, 0x73, 0x74, 0x12, 0x0e, 0x2e, 0x6d, 0x61, 0x66, 0x69, 0x61, 0x70, 0x62, 0x2e, 0x45, 0x6d,
-	0x70, 0x74, 0x79, 0x1a, 0x1b, 0x2e, 0x6d, 0x61, 0x66, 0x69, 0x61, 0x70, 0x62, 0x2e, 0x4d, 0x65,
-	0x6d, 0x62, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x3a, 0x0a, 0x0b, 0x53, 0x65, 0x6e, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12,
-	0x1b, 0x2e, 0x6d, 0x61, 0x66, 0x69, 0x61, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x4d, 0x65,
-	0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x6d,
-	0x61, 0x66, 0x69, 0x61, 0x70, 0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x2c, 0x0a, 0x04,
-	0x45, 0x78, 0x69, 0x74, 0x12, 0x14, 0x2e, 0x6d, 0x61, 0x66, 0x69, 0x61, 0x70, 0x62, 0x2e, 0x45,
-	0x78, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x6d, 0x61, 0x66,
-	0x69, 0x61, 0x70, 0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x4d, 0x0a, 0x14, 0x53, 0x75,
-	0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x54, 0x6f, 0x47, 0x61, 0x6d, 0x65, 0x45, 0x76, 0x65,
-	0x6e, 0x74, 0x12, 0x1f, 0x2e, 0x6d, 0x61, 0x66, 0x69, 0x61, 0x70, 0x62, 0x2e, 0x53, 0x75, 0x62,
-	0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x54, 0x6f, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x6d, 0x61, 0x66, 0x69, 0x61, 0x70, 0x62, 0x2e, 0x47, 0x61,
-	0x6d, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x30, 0x01, 0x12, 0x33, 0x0a, 0x04, 0x52, 0x6f, 0x6c,
-	0x65, 0x12, 0x14, 0x2e, 0x6d, 0x61, 0x66, 0x69, 0x61, 0x70, 0x62, 0x2e, 0x52, 0x6f, 0x6c, 0x65,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x6d, 0x61, 0x66, 0x69, 0x61, 0x70,
-	0x62, 0x2e, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c,
-	0x0a, 0x04, 0x56, 0x6f, 0x74, 0x65, 0x12, 0x14, 0x2e, 0x6d, 0x61, 0x66, 0x69, 0x61, 0x70, 0x62,
-	0x2e, 0x56, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x6d,
-	0x61, 0x66, 0x69, 0x61, 0x70, 0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x2c, 0x0a, 0x04,
-	0x4b, 0x69, 0x6c, 0x6c, 0x12, 0x14, 0x2e, 0x6d, 0x61, 0x66, 0x69, 0x61, 0x70, 0x62, 0x2e, 0x4b,
-	0x69, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x6d, 0x61, 0x66,
-	0x69, 0x61, 0x70, 0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x36, 0x0a, 0x05, 0x43, 0x68,
-	0x65, 0x63, 0x6b, 0x12, 0x15, 0x2e, 0x6d, 0x61, 0x66, 0x69, 0x61, 0x70, 0x62, 0x2e, 0x43, 0x68,
-	0x65, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x6d, 0x61, 0x66,
-	0x69, 0x61, 0x70, 0x62, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x37, 0x0a, 0x09, 0x41, 0x6c, 0x69, 0x76, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x12,
+	0x74, 0x79, 0x12, 0x54, 0x0a, 0x0f, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x54,
+	0x6f, 0x47, 0x61, 0x6d, 0x65, 0x12, 0x1f, 0x2e, 0x6d, 0x61, 0x66, 0x69, 0x61, 0x70, 0x62, 0x2e,
+	0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x54, 0x6f, 0x47, 0x61, 0x6d, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x6d, 0x61, 0x66, 0x69, 0x61, 0x70, 0x62,
+	0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x54, 0x6f, 0x47, 0x61, 0x6d, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0x90, 0x06, 0x0a, 0x04, 0x47, 0x61, 0x6d,
+	0x65, 0x12, 0x39, 0x0a, 0x0a, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x12,
 	0x0e, 0x2e, 0x6d, 0x61, 0x66, 0x69, 0x61, 0x70, 0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a,
-	0x1a, 0x2e, 0x6d, 0x61, 0x66, 0x69, 0x61, 0x70, 0x62, 0x2e, 0x41, 0x6c, 0x69, 0x76, 0x65, 0x4c,
-	0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x10, 0x5a, 0x0e, 0x69,
-	0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x06, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x1b, 0x2e, 0x6d, 0x61, 0x66, 0x69, 0x61, 0x70, 0x62, 0x2e, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72,
+	0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3a, 0x0a, 0x0b,
+	0x53, 0x65, 0x6e, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1b, 0x2e, 0x6d, 0x61,
+	0x66, 0x69, 0x61, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x6d, 0x61, 0x66, 0x69, 0x61,
+	0x70, 0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x2c, 0x0a, 0x04, 0x45, 0x78, 0x69, 0x74,
+	0x12, 0x14, 0x2e, 0x6d, 0x61, 0x66, 0x69, 0x61, 0x70, 0x62, 0x2e, 0x45, 0x78, 0x69, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x6d, 0x61, 0x66, 0x69, 0x61, 0x70, 0x62,
+	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x4d, 0x0a, 0x14, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72,
+	0x69, 0x62, 0x65, 0x54, 0x6f, 0x47, 0x61, 0x6d, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x1f,
+	0x2e, 0x6d, 0x61, 0x66, 0x69, 0x61, 0x70, 0x62, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
+	0x62, 0x65, 0x54, 0x6f, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x12, 0x2e, 0x6d, 0x61, 0x66, 0x69, 0x61, 0x70, 0x62, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x30, 0x01, 0x12, 0x33, 0x0a, 0x04, 0x52, 0x6f, 0x6c, 0x65, 0x12, 0x14, 0x2e,
+	0x6d, 0x61, 0x66, 0x69, 0x61, 0x70, 0x62, 0x2e, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x6d, 0x61, 0x66, 0x69, 0x61, 0x70, 0x62, 0x2e, 0x52, 0x6f,
+	0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x04, 0x56, 0x6f,
+	0x74, 0x65, 0x12, 0x14, 0x2e, 0x6d, 0x61, 0x66, 0x69, 0x61, 0x70, 0x62, 0x2e, 0x56, 0x6f, 0x74,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x6d, 0x61, 0x66, 0x69, 0x61,
+	0x70, 0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x2c, 0x0a, 0x04, 0x4b, 0x69, 0x6c, 0x6c,
+	0x12, 0x14, 0x2e, 0x6d, 0x61, 0x66, 0x69, 0x61, 0x70, 0x62, 0x2e, 0x4b, 0x69, 0x6c, 0x6c, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x6d, 0x61, 0x66, 0x69, 0x61, 0x70, 0x62,
+	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x36, 0x0a, 0x05, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x12,
+	0x15, 0x2e, 0x6d, 0x61, 0x66, 0x69, 0x61, 0x70, 0x62, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x6d, 0x61, 0x66, 0x69, 0x61, 0x70, 0x62,
+	0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x37,
+	0x0a, 0x09, 0x41, 0x6c, 0x69, 0x76, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x0e, 0x2e, 0x6d, 0x61,
+	0x66, 0x69, 0x61, 0x70, 0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x1a, 0x2e, 0x6d, 0x61,
+	0x66, 0x69, 0x61, 0x70, 0x62, 0x2e, 0x41, 0x6c, 0x69, 0x76, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3d, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x53, 0x74,
+	0x61, 0x74, 0x65, 0x12, 0x15, 0x2e, 0x6d, 0x61, 0x66, 0x69, 0x61, 0x70, 0x62, 0x2e, 0x53, 0x74,
+	0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x6d, 0x61, 0x66,
+	0x69, 0x61, 0x70, 0x62, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x35, 0x0a, 0x08, 0x52, 0x6f, 0x6c, 0x65, 0x4c, 0x69,
+	0x73, 0x74, 0x12, 0x0e, 0x2e, 0x6d, 0x61, 0x66, 0x69, 0x61, 0x70, 0x62, 0x2e, 0x45, 0x6d, 0x70,
+	0x74, 0x79, 0x1a, 0x19, 0x2e, 0x6d, 0x61, 0x66, 0x69, 0x61, 0x70, 0x62, 0x2e, 0x52, 0x6f, 0x6c,
+	0x65, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4c, 0x0a,
+	0x10, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x12, 0x15, 0x2e, 0x6d, 0x61, 0x66, 0x69, 0x61, 0x70, 0x62, 0x2e, 0x53, 0x74, 0x61, 0x74,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x6d, 0x61, 0x66, 0x69, 0x61,
+	0x70, 0x62, 0x2e, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x41, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4e, 0x0a, 0x0d, 0x50,
+	0x65, 0x72, 0x66, 0x6f, 0x72, 0x6d, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x2e, 0x6d,
+	0x61, 0x66, 0x69, 0x61, 0x70, 0x62, 0x2e, 0x50, 0x65, 0x72, 0x66, 0x6f, 0x72, 0x6d, 0x41, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x6d, 0x61,
+	0x66, 0x69, 0x61, 0x70, 0x62, 0x2e, 0x50, 0x65, 0x72, 0x66, 0x6f, 0x72, 0x6d, 0x41, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0xf8, 0x02, 0x0a, 0x05,
+	0x41, 0x64, 0x6d, 0x69, 0x6e, 0x12, 0x32, 0x0a, 0x04, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x0e, 0x2e,
+	0x6d, 0x61, 0x66, 0x69, 0x61, 0x70, 0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x1a, 0x2e,
+	0x6d, 0x61, 0x66, 0x69, 0x61, 0x70, 0x62, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4c, 0x69, 0x73,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3e, 0x0a, 0x0b, 0x49, 0x6e, 0x73,
+	0x70, 0x65, 0x63, 0x74, 0x47, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x2e, 0x6d, 0x61, 0x66, 0x69, 0x61,
+	0x70, 0x62, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x49, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x17, 0x2e, 0x6d, 0x61, 0x66, 0x69, 0x61, 0x70, 0x62, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e,
+	0x47, 0x61, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x31, 0x0a, 0x07, 0x45, 0x6e, 0x64,
+	0x47, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x2e, 0x6d, 0x61, 0x66, 0x69, 0x61, 0x70, 0x62, 0x2e, 0x47,
+	0x61, 0x6d, 0x65, 0x49, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x6d,
+	0x61, 0x66, 0x69, 0x61, 0x70, 0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x36, 0x0a, 0x09,
+	0x50, 0x61, 0x75, 0x73, 0x65, 0x47, 0x61, 0x6d, 0x65, 0x12, 0x19, 0x2e, 0x6d, 0x61, 0x66, 0x69,
+	0x61, 0x70, 0x62, 0x2e, 0x50, 0x61, 0x75, 0x73, 0x65, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x6d, 0x61, 0x66, 0x69, 0x61, 0x70, 0x62, 0x2e, 0x45,
+	0x6d, 0x70, 0x74, 0x79, 0x12, 0x2c, 0x0a, 0x04, 0x4b, 0x69, 0x63, 0x6b, 0x12, 0x14, 0x2e, 0x6d,
+	0x61, 0x66, 0x69, 0x61, 0x70, 0x62, 0x2e, 0x4b, 0x69, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x6d, 0x61, 0x66, 0x69, 0x61, 0x70, 0x62, 0x2e, 0x45, 0x6d, 0x70,
+	0x74, 0x79, 0x12, 0x34, 0x0a, 0x08, 0x41, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x12, 0x18,
+	0x2e, 0x6d, 0x61, 0x66, 0x69, 0x61, 0x70, 0x62, 0x2e, 0x41, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x6d, 0x61, 0x66, 0x69, 0x61,
+	0x70, 0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x2c, 0x0a, 0x04, 0x46, 0x6f, 0x75, 0x6c,
+	0x12, 0x14, 0x2e, 0x6d, 0x61, 0x66, 0x69, 0x61, 0x70, 0x62, 0x2e, 0x46, 0x6f, 0x75, 0x6c, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x6d, 0x61, 0x66, 0x69, 0x61, 0x70, 0x62,
+	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x42, 0x10, 0x5a, 0x0e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e,
+	0x61, 0x6c, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1282,77 +3679,154 @@ func file_mafia_proto_rawDescGZIP() []byte {
 	return file_mafia_proto_rawDescData
 }
 
-var file_mafia_proto_msgTypes = make([]protoimpl.MessageInfo, 21)
+var file_mafia_proto_enumTypes = make([]protoimpl.EnumInfo, 5)
+var file_mafia_proto_msgTypes = make([]protoimpl.MessageInfo, 44)
 var file_mafia_proto_goTypes = []interface{}{
-	(*Player)(nil),                  // 0: mafiapb.Player
-	(*JoinRequest)(nil),             // 1: mafiapb.JoinRequest
-	(*Empty)(nil),                   // 2: mafiapb.Empty
-	(*MemberListResponse)(nil),      // 3: mafiapb.MemberListResponse
-	(*AliveListResponse)(nil),       // 4: mafiapb.AliveListResponse
-	(*SendMessageRequest)(nil),      // 5: mafiapb.SendMessageRequest
-	(*ExitRequest)(nil),             // 6: mafiapb.ExitRequest
-	(*SubscribeToGameRequest)(nil),  // 7: mafiapb.SubscribeToGameRequest
-	(*SubscribeToGameResponse)(nil), // 8: mafiapb.SubscribeToGameResponse
-	(*RoleRequest)(nil),             // 9: mafiapb.RoleRequest
-	(*RoleResponse)(nil),            // 10: mafiapb.RoleResponse
-	(*VoteRequest)(nil),             // 11: mafiapb.VoteRequest
-	(*KillRequest)(nil),             // 12: mafiapb.KillRequest
-	(*CheckRequest)(nil),            // 13: mafiapb.CheckRequest
-	(*CheckResponse)(nil),           // 14: mafiapb.CheckResponse
-	(*DayChange)(nil),               // 15: mafiapb.DayChange
-	(*PlayerKilled)(nil),            // 16: mafiapb.PlayerKilled
-	(*PlayerJailed)(nil),            // 17: mafiapb.PlayerJailed
-	(*GameEnd)(nil),                 // 18: mafiapb.GameEnd
-	(*YouDead)(nil),                 // 19: mafiapb.YouDead
-	(*GameEvent)(nil),               // 20: mafiapb.GameEvent
+	(ArgType)(0),                     // 0: mafiapb.ArgType
+	(ActionOutcome)(0),               // 1: mafiapb.ActionOutcome
+	(ErrorReason)(0),                 // 2: mafiapb.ErrorReason
+	(EventType)(0),                   // 3: mafiapb.EventType
+	(Phase)(0),                       // 4: mafiapb.Phase
+	(*Player)(nil),                   // 5: mafiapb.Player
+	(*JoinRequest)(nil),              // 6: mafiapb.JoinRequest
+	(*Empty)(nil),                    // 7: mafiapb.Empty
+	(*MemberListResponse)(nil),       // 8: mafiapb.MemberListResponse
+	(*AliveListResponse)(nil),        // 9: mafiapb.AliveListResponse
+	(*SendMessageRequest)(nil),       // 10: mafiapb.SendMessageRequest
+	(*ExitRequest)(nil),              // 11: mafiapb.ExitRequest
+	(*SubscribeToGameRequest)(nil),   // 12: mafiapb.SubscribeToGameRequest
+	(*SubscribeToGameResponse)(nil),  // 13: mafiapb.SubscribeToGameResponse
+	(*RoleRequest)(nil),              // 14: mafiapb.RoleRequest
+	(*RoleResponse)(nil),             // 15: mafiapb.RoleResponse
+	(*RoleInfo)(nil),                 // 16: mafiapb.RoleInfo
+	(*RoleListResponse)(nil),         // 17: mafiapb.RoleListResponse
+	(*ActionInfo)(nil),               // 18: mafiapb.ActionInfo
+	(*PerformActionRequest)(nil),     // 19: mafiapb.PerformActionRequest
+	(*PerformActionResponse)(nil),    // 20: mafiapb.PerformActionResponse
+	(*AvailableActionsResponse)(nil), // 21: mafiapb.AvailableActionsResponse
+	(*VoteRequest)(nil),              // 22: mafiapb.VoteRequest
+	(*KillRequest)(nil),              // 23: mafiapb.KillRequest
+	(*CheckRequest)(nil),             // 24: mafiapb.CheckRequest
+	(*CheckResponse)(nil),            // 25: mafiapb.CheckResponse
+	(*PhaseChanged)(nil),             // 26: mafiapb.PhaseChanged
+	(*PlayerKilled)(nil),             // 27: mafiapb.PlayerKilled
+	(*PlayerJailed)(nil),             // 28: mafiapb.PlayerJailed
+	(*GameEnd)(nil),                  // 29: mafiapb.GameEnd
+	(*YouDead)(nil),                  // 30: mafiapb.YouDead
+	(*VoteTurn)(nil),                 // 31: mafiapb.VoteTurn
+	(*SpeechTurn)(nil),               // 32: mafiapb.SpeechTurn
+	(*ServerShutdown)(nil),           // 33: mafiapb.ServerShutdown
+	(*GameEvent)(nil),                // 34: mafiapb.GameEvent
+	(*StateRequest)(nil),             // 35: mafiapb.StateRequest
+	(*Seat)(nil),                     // 36: mafiapb.Seat
+	(*CheckResult)(nil),              // 37: mafiapb.CheckResult
+	(*GameStateResponse)(nil),        // 38: mafiapb.GameStateResponse
+	(*LobbyInfo)(nil),                // 39: mafiapb.LobbyInfo
+	(*GameInfo)(nil),                 // 40: mafiapb.GameInfo
+	(*AdminListResponse)(nil),        // 41: mafiapb.AdminListResponse
+	(*GameIdRequest)(nil),            // 42: mafiapb.GameIdRequest
+	(*PlayerInfo)(nil),               // 43: mafiapb.PlayerInfo
+	(*AdminGameState)(nil),           // 44: mafiapb.AdminGameState
+	(*PauseGameRequest)(nil),         // 45: mafiapb.PauseGameRequest
+	(*KickRequest)(nil),              // 46: mafiapb.KickRequest
+	(*FoulRequest)(nil),              // 47: mafiapb.FoulRequest
+	(*AnnounceRequest)(nil),          // 48: mafiapb.AnnounceRequest
+	(*timestamppb.Timestamp)(nil),    // 49: google.protobuf.Timestamp
 }
 var file_mafia_proto_depIdxs = []int32{
-	0,  // 0: mafiapb.JoinRequest.player:type_name -> mafiapb.Player
-	0,  // 1: mafiapb.SendMessageRequest.player:type_name -> mafiapb.Player
-	0,  // 2: mafiapb.ExitRequest.player:type_name -> mafiapb.Player
-	0,  // 3: mafiapb.SubscribeToGameRequest.player:type_name -> mafiapb.Player
-	0,  // 4: mafiapb.RoleRequest.player:type_name -> mafiapb.Player
-	0,  // 5: mafiapb.VoteRequest.player:type_name -> mafiapb.Player
-	0,  // 6: mafiapb.KillRequest.player:type_name -> mafiapb.Player
-	0,  // 7: mafiapb.CheckRequest.player:type_name -> mafiapb.Player
-	15, // 8: mafiapb.GameEvent.day:type_name -> mafiapb.DayChange
-	16, // 9: mafiapb.GameEvent.killed:type_name -> mafiapb.PlayerKilled
-	17, // 10: mafiapb.GameEvent.jailed:type_name -> mafiapb.PlayerJailed
-	18, // 11: mafiapb.GameEvent.end:type_name -> mafiapb.GameEnd
-	19, // 12: mafiapb.GameEvent.dead:type_name -> mafiapb.YouDead
-	1,  // 13: mafiapb.Lobby.Join:input_type -> mafiapb.JoinRequest
-	2,  // 14: mafiapb.Lobby.MemberList:input_type -> mafiapb.Empty
-	5,  // 15: mafiapb.Lobby.SendMessage:input_type -> mafiapb.SendMessageRequest
-	6,  // 16: mafiapb.Lobby.Exit:input_type -> mafiapb.ExitRequest
-	2,  // 17: mafiapb.Lobby.SubscribeToGame:input_type -> mafiapb.Empty
-	2,  // 18: mafiapb.Game.MemberList:input_type -> mafiapb.Empty
-	5,  // 19: mafiapb.Game.SendMessage:input_type -> mafiapb.SendMessageRequest
-	6,  // 20: mafiapb.Game.Exit:input_type -> mafiapb.ExitRequest
-	7,  // 21: mafiapb.Game.SubscribeToGameEvent:input_type -> mafiapb.SubscribeToGameRequest
-	9,  // 22: mafiapb.Game.Role:input_type -> mafiapb.RoleRequest
-	11, // 23: mafiapb.Game.Vote:input_type -> mafiapb.VoteRequest
-	12, // 24: mafiapb.Game.Kill:input_type -> mafiapb.KillRequest
-	13, // 25: mafiapb.Game.Check:input_type -> mafiapb.CheckRequest
-	2,  // 26: mafiapb.Game.AliveList:input_type -> mafiapb.Empty
-	2,  // 27: mafiapb.Lobby.Join:output_type -> mafiapb.Empty
-	3,  // 28: mafiapb.Lobby.MemberList:output_type -> mafiapb.MemberListResponse
-	2,  // 29: mafiapb.Lobby.SendMessage:output_type -> mafiapb.Empty
-	2,  // 30: mafiapb.Lobby.Exit:output_type -> mafiapb.Empty
-	8,  // 31: mafiapb.Lobby.SubscribeToGame:output_type -> mafiapb.SubscribeToGameResponse
-	3,  // 32: mafiapb.Game.MemberList:output_type -> mafiapb.MemberListResponse
-	2,  // 33: mafiapb.Game.SendMessage:output_type -> mafiapb.Empty
-	2,  // 34: mafiapb.Game.Exit:output_type -> mafiapb.Empty
-	20, // 35: mafiapb.Game.SubscribeToGameEvent:output_type -> mafiapb.GameEvent
-	10, // 36: mafiapb.Game.Role:output_type -> mafiapb.RoleResponse
-	2,  // 37: mafiapb.Game.Vote:output_type -> mafiapb.Empty
-	2,  // 38: mafiapb.Game.Kill:output_type -> mafiapb.Empty
-	14, // 39: mafiapb.Game.Check:output_type -> mafiapb.CheckResponse
-	4,  // 40: mafiapb.Game.AliveList:output_type -> mafiapb.AliveListResponse
-	27, // [27:41] is the sub-list for method output_type
-	13, // [13:27] is the sub-list for method input_type
-	13, // [13:13] is the sub-list for extension type_name
-	13, // [13:13] is the sub-list for extension extendee
-	0,  // [0:13] is the sub-list for field type_name
+	5,  // 0: mafiapb.JoinRequest.player:type_name -> mafiapb.Player
+	5,  // 1: mafiapb.SendMessageRequest.player:type_name -> mafiapb.Player
+	5,  // 2: mafiapb.ExitRequest.player:type_name -> mafiapb.Player
+	5,  // 3: mafiapb.SubscribeToGameRequest.player:type_name -> mafiapb.Player
+	5,  // 4: mafiapb.RoleRequest.player:type_name -> mafiapb.Player
+	16, // 5: mafiapb.RoleResponse.info:type_name -> mafiapb.RoleInfo
+	16, // 6: mafiapb.RoleListResponse.roles:type_name -> mafiapb.RoleInfo
+	0,  // 7: mafiapb.ActionInfo.arg:type_name -> mafiapb.ArgType
+	5,  // 8: mafiapb.PerformActionRequest.player:type_name -> mafiapb.Player
+	1,  // 9: mafiapb.PerformActionResponse.outcome:type_name -> mafiapb.ActionOutcome
+	18, // 10: mafiapb.PerformActionResponse.actions:type_name -> mafiapb.ActionInfo
+	4,  // 11: mafiapb.AvailableActionsResponse.phase:type_name -> mafiapb.Phase
+	18, // 12: mafiapb.AvailableActionsResponse.actions:type_name -> mafiapb.ActionInfo
+	5,  // 13: mafiapb.VoteRequest.player:type_name -> mafiapb.Player
+	5,  // 14: mafiapb.KillRequest.player:type_name -> mafiapb.Player
+	5,  // 15: mafiapb.CheckRequest.player:type_name -> mafiapb.Player
+	4,  // 16: mafiapb.PhaseChanged.phase:type_name -> mafiapb.Phase
+	49, // 17: mafiapb.PhaseChanged.deadline:type_name -> google.protobuf.Timestamp
+	49, // 18: mafiapb.SpeechTurn.deadline:type_name -> google.protobuf.Timestamp
+	3,  // 19: mafiapb.GameEvent.type:type_name -> mafiapb.EventType
+	49, // 20: mafiapb.GameEvent.time:type_name -> google.protobuf.Timestamp
+	26, // 21: mafiapb.GameEvent.phase_changed:type_name -> mafiapb.PhaseChanged
+	27, // 22: mafiapb.GameEvent.killed:type_name -> mafiapb.PlayerKilled
+	28, // 23: mafiapb.GameEvent.jailed:type_name -> mafiapb.PlayerJailed
+	29, // 24: mafiapb.GameEvent.end:type_name -> mafiapb.GameEnd
+	30, // 25: mafiapb.GameEvent.dead:type_name -> mafiapb.YouDead
+	33, // 26: mafiapb.GameEvent.shutdown:type_name -> mafiapb.ServerShutdown
+	37, // 27: mafiapb.GameEvent.check_result:type_name -> mafiapb.CheckResult
+	31, // 28: mafiapb.GameEvent.vote_turn:type_name -> mafiapb.VoteTurn
+	32, // 29: mafiapb.GameEvent.speech_turn:type_name -> mafiapb.SpeechTurn
+	5,  // 30: mafiapb.StateRequest.player:type_name -> mafiapb.Player
+	4,  // 31: mafiapb.GameStateResponse.phase:type_name -> mafiapb.Phase
+	49, // 32: mafiapb.GameStateResponse.deadline:type_name -> google.protobuf.Timestamp
+	36, // 33: mafiapb.GameStateResponse.seats:type_name -> mafiapb.Seat
+	37, // 34: mafiapb.GameStateResponse.checks:type_name -> mafiapb.CheckResult
+	39, // 35: mafiapb.AdminListResponse.lobbies:type_name -> mafiapb.LobbyInfo
+	40, // 36: mafiapb.AdminListResponse.games:type_name -> mafiapb.GameInfo
+	43, // 37: mafiapb.AdminGameState.players:type_name -> mafiapb.PlayerInfo
+	6,  // 38: mafiapb.Lobby.Join:input_type -> mafiapb.JoinRequest
+	7,  // 39: mafiapb.Lobby.MemberList:input_type -> mafiapb.Empty
+	10, // 40: mafiapb.Lobby.SendMessage:input_type -> mafiapb.SendMessageRequest
+	11, // 41: mafiapb.Lobby.Exit:input_type -> mafiapb.ExitRequest
+	12, // 42: mafiapb.Lobby.SubscribeToGame:input_type -> mafiapb.SubscribeToGameRequest
+	7,  // 43: mafiapb.Game.MemberList:input_type -> mafiapb.Empty
+	10, // 44: mafiapb.Game.SendMessage:input_type -> mafiapb.SendMessageRequest
+	11, // 45: mafiapb.Game.Exit:input_type -> mafiapb.ExitRequest
+	12, // 46: mafiapb.Game.SubscribeToGameEvent:input_type -> mafiapb.SubscribeToGameRequest
+	14, // 47: mafiapb.Game.Role:input_type -> mafiapb.RoleRequest
+	22, // 48: mafiapb.Game.Vote:input_type -> mafiapb.VoteRequest
+	23, // 49: mafiapb.Game.Kill:input_type -> mafiapb.KillRequest
+	24, // 50: mafiapb.Game.Check:input_type -> mafiapb.CheckRequest
+	7,  // 51: mafiapb.Game.AliveList:input_type -> mafiapb.Empty
+	35, // 52: mafiapb.Game.GetState:input_type -> mafiapb.StateRequest
+	7,  // 53: mafiapb.Game.RoleList:input_type -> mafiapb.Empty
+	35, // 54: mafiapb.Game.AvailableActions:input_type -> mafiapb.StateRequest
+	19, // 55: mafiapb.Game.PerformAction:input_type -> mafiapb.PerformActionRequest
+	7,  // 56: mafiapb.Admin.List:input_type -> mafiapb.Empty
+	42, // 57: mafiapb.Admin.InspectGame:input_type -> mafiapb.GameIdRequest
+	42, // 58: mafiapb.Admin.EndGame:input_type -> mafiapb.GameIdRequest
+	45, // 59: mafiapb.Admin.PauseGame:input_type -> mafiapb.PauseGameRequest
+	46, // 60: mafiapb.Admin.Kick:input_type -> mafiapb.KickRequest
+	48, // 61: mafiapb.Admin.Announce:input_type -> mafiapb.AnnounceRequest
+	47, // 62: mafiapb.Admin.Foul:input_type -> mafiapb.FoulRequest
+	7,  // 63: mafiapb.Lobby.Join:output_type -> mafiapb.Empty
+	8,  // 64: mafiapb.Lobby.MemberList:output_type -> mafiapb.MemberListResponse
+	7,  // 65: mafiapb.Lobby.SendMessage:output_type -> mafiapb.Empty
+	7,  // 66: mafiapb.Lobby.Exit:output_type -> mafiapb.Empty
+	13, // 67: mafiapb.Lobby.SubscribeToGame:output_type -> mafiapb.SubscribeToGameResponse
+	8,  // 68: mafiapb.Game.MemberList:output_type -> mafiapb.MemberListResponse
+	7,  // 69: mafiapb.Game.SendMessage:output_type -> mafiapb.Empty
+	7,  // 70: mafiapb.Game.Exit:output_type -> mafiapb.Empty
+	34, // 71: mafiapb.Game.SubscribeToGameEvent:output_type -> mafiapb.GameEvent
+	15, // 72: mafiapb.Game.Role:output_type -> mafiapb.RoleResponse
+	7,  // 73: mafiapb.Game.Vote:output_type -> mafiapb.Empty
+	7,  // 74: mafiapb.Game.Kill:output_type -> mafiapb.Empty
+	25, // 75: mafiapb.Game.Check:output_type -> mafiapb.CheckResponse
+	9,  // 76: mafiapb.Game.AliveList:output_type -> mafiapb.AliveListResponse
+	38, // 77: mafiapb.Game.GetState:output_type -> mafiapb.GameStateResponse
+	17, // 78: mafiapb.Game.RoleList:output_type -> mafiapb.RoleListResponse
+	21, // 79: mafiapb.Game.AvailableActions:output_type -> mafiapb.AvailableActionsResponse
+	20, // 80: mafiapb.Game.PerformAction:output_type -> mafiapb.PerformActionResponse
+	41, // 81: mafiapb.Admin.List:output_type -> mafiapb.AdminListResponse
+	44, // 82: mafiapb.Admin.InspectGame:output_type -> mafiapb.AdminGameState
+	7,  // 83: mafiapb.Admin.EndGame:output_type -> mafiapb.Empty
+	7,  // 84: mafiapb.Admin.PauseGame:output_type -> mafiapb.Empty
+	7,  // 85: mafiapb.Admin.Kick:output_type -> mafiapb.Empty
+	7,  // 86: mafiapb.Admin.Announce:output_type -> mafiapb.Empty
+	7,  // 87: mafiapb.Admin.Foul:output_type -> mafiapb.Empty
+	63, // [63:88] is the sub-list for method output_type
+	38, // [38:63] is the sub-list for method input_type
+	38, // [38:38] is the sub-list for extension type_name
+	38, // [38:38] is the sub-list for extension extendee
+	0,  // [0:38] is the sub-list for field type_name
 }
 
 func init() { file_mafia_proto_init() }
@@ -1494,7 +3968,7 @@ func file_mafia_proto_init() {
 			}
 		}
 		file_mafia_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*VoteRequest); i {
+			switch v := v.(*RoleInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1506,7 +3980,7 @@ func file_mafia_proto_init() {
 			}
 		}
 		file_mafia_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*KillRequest); i {
+			switch v := v.(*RoleListResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1518,7 +3992,7 @@ func file_mafia_proto_init() {
 			}
 		}
 		file_mafia_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CheckRequest); i {
+			switch v := v.(*ActionInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1530,7 +4004,7 @@ func file_mafia_proto_init() {
 			}
 		}
 		file_mafia_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CheckResponse); i {
+			switch v := v.(*PerformActionRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1542,7 +4016,7 @@ func file_mafia_proto_init() {
 			}
 		}
 		file_mafia_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DayChange); i {
+			switch v := v.(*PerformActionResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1554,7 +4028,7 @@ func file_mafia_proto_init() {
 			}
 		}
 		file_mafia_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PlayerKilled); i {
+			switch v := v.(*AvailableActionsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1566,7 +4040,7 @@ func file_mafia_proto_init() {
 			}
 		}
 		file_mafia_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PlayerJailed); i {
+			switch v := v.(*VoteRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1578,7 +4052,7 @@ func file_mafia_proto_init() {
 			}
 		}
 		file_mafia_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GameEnd); i {
+			switch v := v.(*KillRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1590,7 +4064,7 @@ func file_mafia_proto_init() {
 			}
 		}
 		file_mafia_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*YouDead); i {
+			switch v := v.(*CheckRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1602,6 +4076,114 @@ func file_mafia_proto_init() {
 			}
 		}
 		file_mafia_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CheckResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mafia_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PhaseChanged); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mafia_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PlayerKilled); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mafia_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PlayerJailed); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mafia_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GameEnd); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mafia_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*YouDead); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mafia_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VoteTurn); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mafia_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SpeechTurn); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mafia_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ServerShutdown); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mafia_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GameEvent); i {
 			case 0:
 				return &v.state
@@ -1613,26 +4195,199 @@ func file_mafia_proto_init() {
 				return nil
 			}
 		}
+		file_mafia_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StateRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mafia_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Seat); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mafia_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CheckResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mafia_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GameStateResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mafia_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LobbyInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mafia_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GameInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mafia_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminListResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mafia_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GameIdRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mafia_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PlayerInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mafia_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminGameState); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mafia_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PauseGameRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mafia_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*KickRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mafia_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FoulRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mafia_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AnnounceRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
-	file_mafia_proto_msgTypes[20].OneofWrappers = []interface{}{
-		(*GameEvent_Day)(nil),
+	file_mafia_proto_msgTypes[29].OneofWrappers = []interface{}{
+		(*GameEvent_PhaseChanged)(nil),
 		(*GameEvent_Killed)(nil),
 		(*GameEvent_Jailed)(nil),
 		(*GameEvent_End)(nil),
 		(*GameEvent_Dead)(nil),
+		(*GameEvent_Shutdown)(nil),
+		(*GameEvent_CheckResult)(nil),
+		(*GameEvent_VoteTurn)(nil),
+		(*GameEvent_SpeechTurn)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_mafia_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   21,
+			NumEnums:      5,
+			NumMessages:   44,
 			NumExtensions: 0,
-			NumServices:   2,
+			NumServices:   3,
 		},
 		GoTypes:           file_mafia_proto_goTypes,
 		DependencyIndexes: file_mafia_proto_depIdxs,
+		EnumInfos:         file_mafia_proto_enumTypes,
 		MessageInfos:      file_mafia_proto_msgTypes,
 	}.Build()
 	File_mafia_proto = out.File
@@ -26,7 +26,7 @@ type LobbyClient interface {
 	MemberList(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*MemberListResponse, error)
 	SendMessage(ctx context.Context, in *SendMessageRequest, opts ...grpc.CallOption) (*Empty, error)
 	Exit(ctx context.Context, in *ExitRequest, opts ...grpc.CallOption) (*Empty, error)
-	SubscribeToGame(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*SubscribeToGameResponse, error)
+	SubscribeToGame(ctx context.Context, in *SubscribeToGameRequest, opts ...grpc.CallOption) (*SubscribeToGameResponse, error)
 }
 
 type lobbyClient struct {
@@ -73,7 +73,7 @@ func (c *lobbyClient) Exit(ctx context.Context, in *ExitRequest, opts ...grpc.Ca
 	return out, nil
 }
 
-func (c *lobbyClient) SubscribeToGame(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*SubscribeToGameResponse, error) {
+func (c *lobbyClient) SubscribeToGame(ctx context.Context, in *SubscribeToGameRequest, opts ...grpc.CallOption) (*SubscribeToGameResponse, error) {
 	out := new(SubscribeToGameResponse)
 	err := c.cc.Invoke(ctx, "/mafiapb.Lobby/SubscribeToGame", in, out, opts...)
 	if err != nil {
@@ -90,7 +90,7 @@ type LobbyServer interface {
 	MemberList(context.Context, *Empty) (*MemberListResponse, error)
 	SendMessage(context.Context, *SendMessageRequest) (*Empty, error)
 	Exit(context.Context, *ExitRequest) (*Empty, error)
-	SubscribeToGame(context.Context, *Empty) (*SubscribeToGameResponse, error)
+	SubscribeToGame(context.Context, *SubscribeToGameRequest) (*SubscribeToGameResponse, error)
 	mustEmbedUnimplementedLobbyServer()
 }
 
@@ -110,7 +110,7 @@ func (UnimplementedLobbyServer) SendMessage(context.Context, *SendMessageRequest
 func (UnimplementedLobbyServer) Exit(context.Context, *ExitRequest) (*Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Exit not implemented")
 }
-func (UnimplementedLobbyServer) SubscribeToGame(context.Context, *Empty) (*SubscribeToGameResponse, error) {
+func (UnimplementedLobbyServer) SubscribeToGame(context.Context, *SubscribeToGameRequest) (*SubscribeToGameResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SubscribeToGame not implemented")
 }
 func (UnimplementedLobbyServer) mustEmbedUnimplementedLobbyServer() {}
@@ -199,7 +199,7 @@ func _Lobby_Exit_Handler(srv interface{}, ctx context.Context, dec func(interfac
 }
 
 func _Lobby_SubscribeToGame_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Empty)
+	in := new(SubscribeToGameRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
@@ -211,7 +211,7 @@ func _Lobby_SubscribeToGame_Handler(srv interface{}, ctx context.Context, dec fu
 		FullMethod: "/mafiapb.Lobby/SubscribeToGame",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LobbyServer).SubscribeToGame(ctx, req.(*Empty))
+		return srv.(LobbyServer).SubscribeToGame(ctx, req.(*SubscribeToGameRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -258,9 +258,15 @@ type GameClient interface {
 	SubscribeToGameEvent(ctx context.Context, in *SubscribeToGameRequest, opts ...grpc.CallOption) (Game_SubscribeToGameEventClient, error)
 	Role(ctx context.Context, in *RoleRequest, opts ...grpc.CallOption) (*RoleResponse, error)
 	Vote(ctx context.Context, in *VoteRequest, opts ...grpc.CallOption) (*Empty, error)
+	// Deprecated: use PerformAction
 	Kill(ctx context.Context, in *KillRequest, opts ...grpc.CallOption) (*Empty, error)
+	// Deprecated: use PerformAction
 	Check(ctx context.Context, in *CheckRequest, opts ...grpc.CallOption) (*CheckResponse, error)
 	AliveList(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*AliveListResponse, error)
+	GetState(ctx context.Context, in *StateRequest, opts ...grpc.CallOption) (*GameStateResponse, error)
+	RoleList(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*RoleListResponse, error)
+	AvailableActions(ctx context.Context, in *StateRequest, opts ...grpc.CallOption) (*AvailableActionsResponse, error)
+	PerformAction(ctx context.Context, in *PerformActionRequest, opts ...grpc.CallOption) (*PerformActionResponse, error)
 }
 
 type gameClient struct {
@@ -375,6 +381,42 @@ func (c *gameClient) AliveList(ctx context.Context, in *Empty, opts ...grpc.Call
 	return out, nil
 }
 
+func (c *gameClient) GetState(ctx context.Context, in *StateRequest, opts ...grpc.CallOption) (*GameStateResponse, error) {
+	out := new(GameStateResponse)
+	err := c.cc.Invoke(ctx, "/mafiapb.Game/GetState", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameClient) RoleList(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*RoleListResponse, error) {
+	out := new(RoleListResponse)
+	err := c.cc.Invoke(ctx, "/mafiapb.Game/RoleList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameClient) AvailableActions(ctx context.Context, in *StateRequest, opts ...grpc.CallOption) (*AvailableActionsResponse, error) {
+	out := new(AvailableActionsResponse)
+	err := c.cc.Invoke(ctx, "/mafiapb.Game/AvailableActions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameClient) PerformAction(ctx context.Context, in *PerformActionRequest, opts ...grpc.CallOption) (*PerformActionResponse, error) {
+	out := new(PerformActionResponse)
+	err := c.cc.Invoke(ctx, "/mafiapb.Game/PerformAction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // GameServer is the server API for Game service.
 // All implementations must embed UnimplementedGameServer
 // for forward compatibility
@@ -385,9 +427,15 @@ type GameServer interface {
 	SubscribeToGameEvent(*SubscribeToGameRequest, Game_SubscribeToGameEventServer) error
 	Role(context.Context, *RoleRequest) (*RoleResponse, error)
 	Vote(context.Context, *VoteRequest) (*Empty, error)
+	// Deprecated: use PerformAction
 	Kill(context.Context, *KillRequest) (*Empty, error)
+	// Deprecated: use PerformAction
 	Check(context.Context, *CheckRequest) (*CheckResponse, error)
 	AliveList(context.Context, *Empty) (*AliveListResponse, error)
+	GetState(context.Context, *StateRequest) (*GameStateResponse, error)
+	RoleList(context.Context, *Empty) (*RoleListResponse, error)
+	AvailableActions(context.Context, *StateRequest) (*AvailableActionsResponse, error)
+	PerformAction(context.Context, *PerformActionRequest) (*PerformActionResponse, error)
 	mustEmbedUnimplementedGameServer()
 }
 
@@ -422,6 +470,18 @@ func (UnimplementedGameServer) Check(context.Context, *CheckRequest) (*CheckResp
 func (UnimplementedGameServer) AliveList(context.Context, *Empty) (*AliveListResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AliveList not implemented")
 }
+func (UnimplementedGameServer) GetState(context.Context, *StateRequest) (*GameStateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetState not implemented")
+}
+func (UnimplementedGameServer) RoleList(context.Context, *Empty) (*RoleListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RoleList not implemented")
+}
+func (UnimplementedGameServer) AvailableActions(context.Context, *StateRequest) (*AvailableActionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AvailableActions not implemented")
+}
+func (UnimplementedGameServer) PerformAction(context.Context, *PerformActionRequest) (*PerformActionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PerformAction not implemented")
+}
 func (UnimplementedGameServer) mustEmbedUnimplementedGameServer() {}
 
 // UnsafeGameServer may be embedded to opt out of forward compatibility for this service.
@@ -600,6 +660,78 @@ func _Game_AliveList_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Game_GetState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameServer).GetState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mafiapb.Game/GetState",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameServer).GetState(ctx, req.(*StateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Game_RoleList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameServer).RoleList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mafiapb.Game/RoleList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameServer).RoleList(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Game_AvailableActions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameServer).AvailableActions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mafiapb.Game/AvailableActions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameServer).AvailableActions(ctx, req.(*StateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Game_PerformAction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PerformActionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameServer).PerformAction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mafiapb.Game/PerformAction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameServer).PerformAction(ctx, req.(*PerformActionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Game_ServiceDesc is the grpc.ServiceDesc for Game service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -639,6 +771,22 @@ var Game_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "AliveList",
 			Handler:    _Game_AliveList_Handler,
 		},
+		{
+			MethodName: "GetState",
+			Handler:    _Game_GetState_Handler,
+		},
+		{
+			MethodName: "RoleList",
+			Handler:    _Game_RoleList_Handler,
+		},
+		{
+			MethodName: "AvailableActions",
+			Handler:    _Game_AvailableActions_Handler,
+		},
+		{
+			MethodName: "PerformAction",
+			Handler:    _Game_PerformAction_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -649,3 +797,305 @@ var Game_ServiceDesc = grpc.ServiceDesc{
 	},
 	Metadata: "mafia.proto",
 }
+
+// AdminClient is the client API for Admin service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type AdminClient interface {
+	List(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*AdminListResponse, error)
+	InspectGame(ctx context.Context, in *GameIdRequest, opts ...grpc.CallOption) (*AdminGameState, error)
+	EndGame(ctx context.Context, in *GameIdRequest, opts ...grpc.CallOption) (*Empty, error)
+	PauseGame(ctx context.Context, in *PauseGameRequest, opts ...grpc.CallOption) (*Empty, error)
+	Kick(ctx context.Context, in *KickRequest, opts ...grpc.CallOption) (*Empty, error)
+	Announce(ctx context.Context, in *AnnounceRequest, opts ...grpc.CallOption) (*Empty, error)
+	Foul(ctx context.Context, in *FoulRequest, opts ...grpc.CallOption) (*Empty, error)
+}
+
+type adminClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAdminClient(cc grpc.ClientConnInterface) AdminClient {
+	return &adminClient{cc}
+}
+
+func (c *adminClient) List(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*AdminListResponse, error) {
+	out := new(AdminListResponse)
+	err := c.cc.Invoke(ctx, "/mafiapb.Admin/List", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) InspectGame(ctx context.Context, in *GameIdRequest, opts ...grpc.CallOption) (*AdminGameState, error) {
+	out := new(AdminGameState)
+	err := c.cc.Invoke(ctx, "/mafiapb.Admin/InspectGame", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) EndGame(ctx context.Context, in *GameIdRequest, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/mafiapb.Admin/EndGame", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) PauseGame(ctx context.Context, in *PauseGameRequest, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/mafiapb.Admin/PauseGame", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) Kick(ctx context.Context, in *KickRequest, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/mafiapb.Admin/Kick", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) Announce(ctx context.Context, in *AnnounceRequest, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/mafiapb.Admin/Announce", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) Foul(ctx context.Context, in *FoulRequest, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/mafiapb.Admin/Foul", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AdminServer is the server API for Admin service.
+// All implementations must embed UnimplementedAdminServer
+// for forward compatibility
+type AdminServer interface {
+	List(context.Context, *Empty) (*AdminListResponse, error)
+	InspectGame(context.Context, *GameIdRequest) (*AdminGameState, error)
+	EndGame(context.Context, *GameIdRequest) (*Empty, error)
+	PauseGame(context.Context, *PauseGameRequest) (*Empty, error)
+	Kick(context.Context, *KickRequest) (*Empty, error)
+	Announce(context.Context, *AnnounceRequest) (*Empty, error)
+	Foul(context.Context, *FoulRequest) (*Empty, error)
+	mustEmbedUnimplementedAdminServer()
+}
+
+// UnimplementedAdminServer must be embedded to have forward compatible implementations.
+type UnimplementedAdminServer struct {
+}
+
+func (UnimplementedAdminServer) List(context.Context, *Empty) (*AdminListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method List not implemented")
+}
+func (UnimplementedAdminServer) InspectGame(context.Context, *GameIdRequest) (*AdminGameState, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InspectGame not implemented")
+}
+func (UnimplementedAdminServer) EndGame(context.Context, *GameIdRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EndGame not implemented")
+}
+func (UnimplementedAdminServer) PauseGame(context.Context, *PauseGameRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PauseGame not implemented")
+}
+func (UnimplementedAdminServer) Kick(context.Context, *KickRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Kick not implemented")
+}
+func (UnimplementedAdminServer) Announce(context.Context, *AnnounceRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Announce not implemented")
+}
+func (UnimplementedAdminServer) Foul(context.Context, *FoulRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Foul not implemented")
+}
+func (UnimplementedAdminServer) mustEmbedUnimplementedAdminServer() {}
+
+// UnsafeAdminServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to AdminServer will
+// result in compilation errors.
+type UnsafeAdminServer interface {
+	mustEmbedUnimplementedAdminServer()
+}
+
+func RegisterAdminServer(s grpc.ServiceRegistrar, srv AdminServer) {
+	s.RegisterService(&Admin_ServiceDesc, srv)
+}
+
+func _Admin_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).List(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mafiapb.Admin/List",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).List(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_InspectGame_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GameIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).InspectGame(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mafiapb.Admin/InspectGame",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).InspectGame(ctx, req.(*GameIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_EndGame_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GameIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).EndGame(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mafiapb.Admin/EndGame",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).EndGame(ctx, req.(*GameIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_PauseGame_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PauseGameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).PauseGame(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mafiapb.Admin/PauseGame",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).PauseGame(ctx, req.(*PauseGameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_Kick_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(KickRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).Kick(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mafiapb.Admin/Kick",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).Kick(ctx, req.(*KickRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_Announce_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AnnounceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).Announce(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mafiapb.Admin/Announce",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).Announce(ctx, req.(*AnnounceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_Foul_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FoulRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).Foul(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mafiapb.Admin/Foul",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).Foul(ctx, req.(*FoulRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Admin_ServiceDesc is the grpc.ServiceDesc for Admin service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Admin_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "mafiapb.Admin",
+	HandlerType: (*AdminServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "List",
+			Handler:    _Admin_List_Handler,
+		},
+		{
+			MethodName: "InspectGame",
+			Handler:    _Admin_InspectGame_Handler,
+		},
+		{
+			MethodName: "EndGame",
+			Handler:    _Admin_EndGame_Handler,
+		},
+		{
+			MethodName: "PauseGame",
+			Handler:    _Admin_PauseGame_Handler,
+		},
+		{
+			MethodName: "Kick",
+			Handler:    _Admin_Kick_Handler,
+		},
+		{
+			MethodName: "Announce",
+			Handler:    _Admin_Announce_Handler,
+		},
+		{
+			MethodName: "Foul",
+			Handler:    _Admin_Foul_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "mafia.proto",
+}
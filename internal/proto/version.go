@@ -0,0 +1,4 @@
+package proto
+
+// EventSchemaVersion is sent in every GameEvent, bump it on incompatible event changes
+const EventSchemaVersion int32 = 2
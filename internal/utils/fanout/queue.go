@@ -0,0 +1,214 @@
+package fanout
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"sync"
+
+	"github.com/GandarfHSE/go-mafia/internal/utils/logger"
+)
+
+// Policy decides what happens when subscriber doesn't keep up and its queue is full
+type Policy string
+
+const (
+	// Oldest queued item is dropped to make room for the new one
+	DropOldest Policy = "drop-oldest"
+	// Queue is cleared and subscriber gets ErrOverflow, so it can reconnect and resync
+	Disconnect Policy = "disconnect"
+	// Items which don't fit are written to a file and read back in order
+	Spill Policy = "spill"
+)
+
+var ErrOverflow = errors.New("subscriber is too slow, queue overflowed")
+
+func ParsePolicy(s string) (Policy, error) {
+	switch p := Policy(s); p {
+	case DropOldest, Disconnect, Spill:
+		return p, nil
+	}
+	return "", fmt.Errorf("unknown queue policy %q, expected drop-oldest, disconnect or spill", s)
+}
+
+// Codec serializes items for Spill policy
+type Codec[T any] interface {
+	Marshal(T) ([]byte, error)
+	Unmarshal([]byte) (T, error)
+}
+
+type Options struct {
+	Size   int
+	Policy Policy
+	// Directory for spill files, system temp dir is used if empty
+	SpillDir string
+	// Called for every dropped item
+	OnDrop func()
+}
+
+// Queue is a bounded per-subscriber queue. Push never blocks, so one slow
+// subscriber can't stall the producer or other subscribers.
+type Queue[T any] struct {
+	opts  Options
+	codec Codec[T]
+
+	mu         sync.Mutex
+	items      []T
+	spill      *spillFile
+	closed     bool
+	overflowed bool
+	ready      chan struct{}
+}
+
+// codec is required only for Spill policy, queue falls back to DropOldest without it
+func CreateQueue[T any](opts Options, codec Codec[T]) *Queue[T] {
+	if opts.Size <= 0 {
+		opts.Size = 1
+	}
+	if opts.Policy == "" || (opts.Policy == Spill && codec == nil) {
+		opts.Policy = DropOldest
+	}
+	return &Queue[T]{
+		opts:  opts,
+		codec: codec,
+		ready: make(chan struct{}, 1),
+	}
+}
+
+func (q *Queue[T]) Push(v T) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if q.closed {
+		return
+	}
+	defer q.signal()
+
+	if q.spill.pending() > 0 || len(q.items) >= q.opts.Size {
+		switch q.opts.Policy {
+		case Disconnect:
+			q.drop(len(q.items) + 1)
+			q.items = nil
+			q.overflowed = true
+			return
+		case Spill:
+			err := q.spillPush(v)
+			if err == nil {
+				return
+			}
+			slog.Warn("Can't spill queue item to disk", logger.Error, err)
+			q.drop(1)
+			return
+		default:
+			q.drop(1)
+			q.items = q.items[1:]
+		}
+	}
+	q.items = append(q.items, v)
+}
+
+// Pop waits for the next item. It returns io.EOF when queue is closed and drained,
+// ErrOverflow once after the queue was cleared by Disconnect policy.
+func (q *Queue[T]) Pop(ctx context.Context) (T, error) {
+	var zero T
+	for {
+		q.mu.Lock()
+		if q.overflowed {
+			q.overflowed = false
+			q.mu.Unlock()
+			return zero, ErrOverflow
+		}
+		if len(q.items) > 0 {
+			v := q.items[0]
+			q.items = q.items[1:]
+			q.mu.Unlock()
+			return v, nil
+		}
+		if q.spill.pending() > 0 {
+			v, err := q.spillPop()
+			q.mu.Unlock()
+			if err == nil {
+				return v, nil
+			}
+			slog.Warn("Can't read spilled queue item", logger.Error, err)
+			continue
+		}
+		if q.closed {
+			q.mu.Unlock()
+			return zero, io.EOF
+		}
+		q.mu.Unlock()
+
+		select {
+		case <-q.ready:
+		case <-ctx.Done():
+			return zero, ctx.Err()
+		}
+	}
+}
+
+// Close stops accepting items. Already queued items can still be popped,
+// spilled ones are loaded back to memory so that no file outlives the queue.
+func (q *Queue[T]) Close() {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if q.closed {
+		return
+	}
+	q.closed = true
+	for q.spill.pending() > 0 {
+		v, err := q.spillPop()
+		if err != nil {
+			slog.Warn("Can't read spilled queue item", logger.Error, err)
+			continue
+		}
+		q.items = append(q.items, v)
+	}
+	if q.spill != nil {
+		q.spill.remove()
+		q.spill = nil
+	}
+	q.signal()
+}
+
+func (q *Queue[T]) signal() {
+	select {
+	case q.ready <- struct{}{}:
+	default:
+	}
+}
+
+func (q *Queue[T]) drop(n int) {
+	if q.opts.OnDrop == nil {
+		return
+	}
+	for i := 0; i < n; i++ {
+		q.opts.OnDrop()
+	}
+}
+
+func (q *Queue[T]) spillPush(v T) error {
+	data, err := q.codec.Marshal(v)
+	if err != nil {
+		return err
+	}
+	if q.spill == nil {
+		q.spill, err = createSpillFile(q.opts.SpillDir)
+		if err != nil {
+			return err
+		}
+	}
+	return q.spill.write(data)
+}
+
+func (q *Queue[T]) spillPop() (T, error) {
+	data, err := q.spill.read()
+	if err != nil {
+		var zero T
+		return zero, err
+	}
+	return q.codec.Unmarshal(data)
+}
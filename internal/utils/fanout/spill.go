@@ -0,0 +1,80 @@
+package fanout
+
+import (
+	"encoding/binary"
+	"os"
+)
+
+// spillFile is an append-only file of length-prefixed records, read from the beginning.
+// It is truncated every time all records are read.
+type spillFile struct {
+	f        *os.File
+	readOff  int64
+	writeOff int64
+	count    int
+}
+
+func createSpillFile(dir string) (*spillFile, error) {
+	if dir != "" {
+		if err := os.MkdirAll(dir, 0o755); err != nil {
+			return nil, err
+		}
+	}
+	f, err := os.CreateTemp(dir, "queue-*.spill")
+	if err != nil {
+		return nil, err
+	}
+	return &spillFile{f: f}, nil
+}
+
+func (s *spillFile) pending() int {
+	if s == nil {
+		return 0
+	}
+	return s.count
+}
+
+func (s *spillFile) write(data []byte) error {
+	rec := binary.AppendUvarint(nil, uint64(len(data)))
+	rec = append(rec, data...)
+	if _, err := s.f.WriteAt(rec, s.writeOff); err != nil {
+		return err
+	}
+	s.writeOff += int64(len(rec))
+	s.count += 1
+	return nil
+}
+
+func (s *spillFile) read() ([]byte, error) {
+	// Record is consumed even if it is broken, so one bad record doesn't block the queue
+	s.count -= 1
+	defer s.reset()
+
+	head := make([]byte, binary.MaxVarintLen64)
+	n, _ := s.f.ReadAt(head, s.readOff)
+	size, k := binary.Uvarint(head[:n])
+	if k <= 0 {
+		s.count = 0
+		return nil, os.ErrInvalid
+	}
+	data := make([]byte, size)
+	if _, err := s.f.ReadAt(data, s.readOff+int64(k)); err != nil {
+		s.count = 0
+		return nil, err
+	}
+	s.readOff += int64(k) + int64(size)
+	return data, nil
+}
+
+func (s *spillFile) reset() {
+	if s.count > 0 {
+		return
+	}
+	s.readOff, s.writeOff, s.count = 0, 0, 0
+	s.f.Truncate(0)
+}
+
+func (s *spillFile) remove() {
+	s.f.Close()
+	os.Remove(s.f.Name())
+}
@@ -0,0 +1,93 @@
+package grpcutil
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/GandarfHSE/go-mafia/internal/proto"
+	"github.com/GandarfHSE/go-mafia/internal/utils/i18n"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const ErrorDomain string = "mafia"
+
+// Error creates status with machine-readable reason and arguments of its message,
+// msg is for clients which don't know the reason
+func Error(code codes.Code, reason proto.ErrorReason, msg string, args ...any) error {
+	st := status.New(code, msg)
+	info := &errdetails.ErrorInfo{Reason: reason.String(), Domain: ErrorDomain}
+	if len(args) > 0 {
+		info.Metadata = make(map[string]string)
+		for i, a := range args {
+			info.Metadata[argKey(i)] = fmt.Sprint(a)
+		}
+	}
+	detailed, err := st.WithDetails(info)
+	if err != nil {
+		return st.Err()
+	}
+	return detailed.Err()
+}
+
+func argKey(i int) string {
+	return fmt.Sprintf("arg%v", i)
+}
+
+func errorInfo(err error) *errdetails.ErrorInfo {
+	st, ok := status.FromError(err)
+	if !ok {
+		return nil
+	}
+	for _, d := range st.Details() {
+		if info, ok := d.(*errdetails.ErrorInfo); ok && info.Domain == ErrorDomain {
+			return info
+		}
+	}
+	return nil
+}
+
+// Reason returns reason of error created by Error, ERROR_REASON_UNSPECIFIED for other errors
+func Reason(err error) proto.ErrorReason {
+	info := errorInfo(err)
+	if info == nil {
+		return proto.ErrorReason_ERROR_REASON_UNSPECIFIED
+	}
+	return proto.ErrorReason(proto.ErrorReason_value[info.Reason])
+}
+
+// Args returns arguments of error message created by Error
+func Args(err error) []string {
+	info := errorInfo(err)
+	if info == nil {
+		return nil
+	}
+	args := make([]string, 0)
+	for i := 0; ; i++ {
+		a, ok := info.Metadata[argKey(i)]
+		if !ok {
+			return args
+		}
+		args = append(args, a)
+	}
+}
+
+// ReasonKey returns i18n key of error reason
+func ReasonKey(reason proto.ErrorReason) string {
+	return "error." + strings.ToLower(strings.TrimPrefix(reason.String(), "ERROR_REASON_"))
+}
+
+// ErrorText renders error returned by server, falls back to server message for unknown reasons
+func ErrorText(tr *i18n.Printer, err error) string {
+	if reason := Reason(err); reason != proto.ErrorReason_ERROR_REASON_UNSPECIFIED {
+		key := ReasonKey(reason)
+		if text := tr.Render(i18n.Message{Key: key, Args: Args(err)}); text != key {
+			return text
+		}
+	}
+	if st, ok := status.FromError(err); ok {
+		return st.Message()
+	}
+	return err.Error()
+}
@@ -0,0 +1,27 @@
+package grpcutil
+
+import (
+	"time"
+
+	"google.golang.org/grpc"
+)
+
+const (
+	// TokenHeader is the metadata key carrying admin credential
+	TokenHeader string = "admin-token"
+)
+
+// GracefulStop waits for pending RPCs up to timeout, then closes all connections
+func GracefulStop(srv *grpc.Server, timeout time.Duration) {
+	stopped := make(chan struct{})
+	go func() {
+		srv.GracefulStop()
+		close(stopped)
+	}()
+
+	select {
+	case <-stopped:
+	case <-time.After(timeout):
+		srv.Stop()
+	}
+}
@@ -0,0 +1,228 @@
+package i18n
+
+var en = map[string]string{
+	// Chat messages sent by server
+	"chat.message":            "[%v] %v",
+	"chat.joined":             "Player %v has joined the lobby!",
+	"chat.left":               "Player %v has left!",
+	"chat.lobby_full":         "Lobby is full...",
+	"chat.lobby_shutdown":     "Server is shutting down, no new games will start!",
+	"chat.kicked_you_lobby":   "You have been kicked from the lobby by administrator!",
+	"chat.kicked_lobby":       "Player %v has been kicked from the lobby!",
+	"chat.kicked_you_game":    "You have been kicked from the game by administrator!",
+	"chat.kicked_game":        "Player %v has been kicked from the game!",
+	"chat.announce":           "%v",
+	"chat.paused":             "The game is paused by administrator!",
+	"chat.resumed":            "The game goes on!",
+	"chat.restored":           "The game is restored after server restart!",
+	"chat.restored_paused":    "The game is restored after server restart, it continues when administrator resumes it!",
+	"chat.day":                "A new day - a new vote!",
+	"chat.night":              "The town falls asleep...",
+	"chat.voted":              "Player #%v voted against player #%v!",
+	"chat.vote_skipped":       "Player #%v decided not to vote!",
+	"chat.nominated":          "Player #%v nominated player #%v!",
+	"chat.nomination_skipped": "Player #%v nominates nobody!",
+	"chat.voting":             "Voting! Nominees: %v",
+	"chat.vote_result":        "Votes for player #%v: %v",
+	"chat.no_jail":            "Nobody is found guilty today, let's try tomorrow...",
+	"chat.no_kill":            "Nobody died tonight!",
+	"chat.last_words":         "Last words: %v. Everybody else keeps silence...",
+	"chat.stopped_shutdown":   "The game is stopped, server is shutting down!",
+	"chat.saved_shutdown":     "Server is shutting down, the game is saved and will continue after restart!",
+	"chat.stopped_admin":      "The game is stopped by administrator!",
+	"chat.meeting":            "The town sleeps, mafia meets and makes plans...",
+	"chat.foul":               "Player #%v gets a foul (%v of %v)!",
+	"chat.removed":            "Player #%v is removed from the game for fouls!",
+	"chat.best_move":          "Best move of player #%v: %v",
+	"chat.best_move_result":   "Player #%v guessed mafia in the best move: %v",
+
+	// Roles, teams and actions
+	"team.civ":           "Civilians",
+	"team.maf":           "Mafia",
+	"role.civ":           "Civilian",
+	"role.civ.hint":      "The full moon outside the window makes you anxious...",
+	"role.com":           "Commissar",
+	"role.com.hint":      "It's time to hunt the mafia! Use !check to investigate a player",
+	"role.maf":           "Mafia",
+	"role.maf.hint":      "It's time to find a victim! Use !kill to kill a player",
+	"role.don":           "Don",
+	"role.don.hint":      "The family is waiting for your orders! Use !kill to kill a player and !seek to look for the commissar",
+	"action.vote":        "Vote to jail a player",
+	"action.nominate":    "Nominate a player for the vote",
+	"action.check":       "Find out the role of a player",
+	"action.check.done":  "You dug up the archives, everything will be clear by morning...",
+	"action.kill":        "Kill a player",
+	"action.kill.done":   "Looks like your victim won't live till morning...",
+	"action.pass":        "Finish your last words",
+	"action.seek":        "Find out whether a player is the commissar",
+	"action.seek.done":   "Your people are watching the player, everything will be clear by morning...",
+	"action.end_meeting": "Finish the meeting",
+	"action.end_speech":  "Finish your speech",
+	"action.best_move":   "Name players you suspect",
+
+	// Errors by ErrorReason
+	"error.bad_request":    "bad request",
+	"error.unknown_player": "player not found",
+	"error.dead_player":    "the dead can't act",
+	"error.wrong_role":     "your role doesn't allow it",
+	"error.wrong_phase":    "you can't do it now",
+	"error.unknown_action": "unknown action",
+	"error.wrong_target":   "you can't choose this player",
+	"error.target_dead":    "this player is already dead",
+	"error.already_acted":  "you have already made your move",
+	"error.game_paused":    "the game is paused by administrator",
+	"error.game_over":      "the game is already over",
+	"error.name_taken":     "this name is already taken",
+	"error.banned":         "this name is banned",
+	"error.shutting_down":  "server is shutting down",
+	"error.too_slow":       "too many unread events",
+	"error.not_your_turn":  "it's not your turn",
+	"error.unknown_game":   "game %v not found",
+	"error.bad_token":      "invalid admin token",
+
+	// Lobby client
+	"lobby.welcome":       "~ Welcome to the Mafia console app! ~",
+	"lobby.ask_name":      "Enter your name:",
+	"lobby.hello":         "Hello, %v!",
+	"lobby.connecting":    "Connecting to the lobby...",
+	"lobby.connected":     "Connected successfully!",
+	"lobby.join_failed":   "Can't join the lobby: %v",
+	"lobby.players_count": "Players in the lobby: [%v/4]",
+	"lobby.shutdown":      "Server is shutting down, see you!",
+
+	// Game client
+	"game.press_enter":     "Enter any line to continue...",
+	"game.prompt":          "Enter a command or a chat message:",
+	"game.bad_command":     "Wrong command! Enter !help for the list of commands",
+	"game.send_failed":     "Can't send the message: %v",
+	"game.start":           "The game begins!",
+	"game.your_role":       "Your role: ",
+	"game.help_hint":       "Enter !help for the list of available commands",
+	"game.dead_chat":       "A breeze from the graveyard reminded a passer-by of you...",
+	"game.night_chat":      "You tried to break the silence of the night, but nobody heard you...",
+	"game.connection_lost": "Connection to the server is lost, reconnecting...",
+	"game.reconnected":     "Connection is restored!",
+	"game.killed":          "The body of player %v was found in a ditch this morning...",
+	"game.jailed":          "Player %v was hanged in the square!",
+	"game.vote_turn":       "Player %v votes now",
+	"game.your_vote_turn":  "Your turn to vote!",
+	"game.speech_turn":     "Player %v speaks now",
+	"game.your_speech":     "Your speech! Others keep silence",
+	"game.seek_found":      "Player #%v is the commissar!",
+	"game.seek_missed":     "Player #%v is not the commissar",
+	"game.over":            "Game over!",
+	"game.winner":          "Winners: %v!",
+	"game.no_winner":       "The game is stopped without a winner!",
+	"game.roles":           "Roles:",
+	"game.shutdown":        "Server is shutting down!",
+	"game.shutdown_drain":  "Server is shutting down! You have %v seconds to finish the game...",
+	"game.check_result":    "After going through the archives you found out that the role of player #%v is ",
+	"game.you_dead":        "You are dead! :(",
+	"game.players_count":   "Players in the game: [%v/4]",
+	"game.alive":           "Players alive:",
+
+	// Commands
+	"cmd.list_title":    "Commands:",
+	"cmd.help":          "Show the list of commands",
+	"cmd.list":          "Show all players",
+	"cmd.exit":          "Leave the game",
+	"cmd.role":          "Remind your role",
+	"cmd.alive":         "Show players alive",
+	"cmd.state":         "Show the game state",
+	"cmd.can_skip":      ", pid = 0 means skip",
+	"cmd.max_targets":   ", up to %v players",
+	"cmd.few_args":      "Too few arguments for command %v!",
+	"cmd.bad_pid":       "Wrong player number: %v",
+	"cmd.role_failed":   "Can't get your role: %v",
+	"cmd.action_failed": "Can't perform the action: %v",
+	"cmd.alive_failed":  "Can't get players alive: %v",
+	"cmd.state_failed":  "Can't get the game state: %v",
+
+	// Game state
+	"state.ended":        "The game is over.",
+	"state.day":          "Day %v.",
+	"state.night":        "Night %v.",
+	"state.last_words":   "Last words, day %v.",
+	"state.voting":       "Voting, day %v.",
+	"state.meeting":      "Meeting of mafia.",
+	"state.paused":       "The game is paused by administrator.",
+	"state.players":      "Players:",
+	"state.you":          " (you)",
+	"state.dead":         " - dead",
+	"state.disconnected": " - disconnected",
+	"state.nominee":      " - nominated",
+	"state.voter":        " - votes now",
+	"state.speaker":      " - speaks now",
+	"state.fouls":        ", fouls: %v",
+	"state.no_vote":      ", not voting",
+	"state.vote":         ", votes against #%v",
+	"state.check":        "Check on night %v: player #%v - ",
+	"state.seek_found":   "Seek on night %v: player #%v is the commissar",
+	"state.seek_missed":  "Seek on night %v: player #%v is not the commissar",
+
+	// Full-screen client
+	"tui.title":       "Mafia",
+	"tui.chat":        "Chat",
+	"tui.log":         "Events",
+	"tui.lobby":       "lobby",
+	"tui.name_prompt": "Name: ",
+	"tui.hint":        "!help - commands, Up/Down - input history, Ctrl-C - quit",
+	"tui.day":         "day %v",
+	"tui.night":       "night %v",
+	"tui.last_words":  "last words",
+	"tui.voting":      "voting",
+	"tui.meeting":     "meeting",
+	"tui.left":        "%v left",
+	"tui.paused":      "paused",
+	"tui.dead":        "you are dead",
+	"tui.ended":       "game over",
+	"tui.actions":     "Actions:",
+	"tui.game_over":   "Enter !exit to quit",
+
+	// Web client
+	"web.join":    "Join",
+	"web.send":    "Send",
+	"web.members": "In the lobby:",
+	"web.waiting": "Waiting for players...",
+	"web.skip":    "Skip",
+	"web.again":   "Play again",
+	"web.closed":  "Connection to the gateway is closed",
+
+	// Admin CLI
+	"admin.usage": "Commands:\n" +
+		"  list                 - lobbies and active games\n" +
+		"  inspect <game_id>    - full game state\n" +
+		"  end <game_id>        - end the game\n" +
+		"  pause <game_id>      - pause the game\n" +
+		"  resume <game_id>     - resume the game\n" +
+		"  kick <name>          - kick the player\n" +
+		"  ban <name>           - kick and ban the player\n" +
+		"  foul <name>          - give the player a foul (sport rules)\n" +
+		"  announce <msg...>    - send an announcement to all players\n",
+	"admin.error":           "Admin error: %v",
+	"admin.no_command":      "no command",
+	"admin.unknown_command": "unknown command %v",
+	"admin.need_game_id":    "%v: game id is required",
+	"admin.need_name":       "%v: player name is required",
+	"admin.need_msg":        "%v: message is required",
+	"admin.lobby":           "Lobby #%v: [%v/%v] %v",
+	"admin.no_games":        "No active games",
+	"admin.game":            "Game %v at %v%v: alive %v/%v, %v",
+	"admin.paused":          " (paused)",
+	"admin.inspect":         "Game %v, phase: %v%v",
+	"admin.player":          "#%v. %v (%v) - %v, %v, vote: %v, votes against: %v, fouls: %v",
+	"admin.alive":           "alive",
+	"admin.dead":            "dead",
+	"admin.vote_skipped":    "skip",
+
+	// Simulator and load test reports
+	"simulate.games":    "Games played: %v",
+	"simulate.wins":     "Wins:",
+	"simulate.town":     "civilians",
+	"simulate.mafia":    "mafia",
+	"simulate.draw":     "draw",
+	"simulate.length":   "Average game length: %.2f days, %.1f actions",
+	"simulate.survived": "Survive till the end:",
+	"loadtest.summary":  "Duration: %v, clients finished: %v, failed: %v, game ends received: %v",
+	"loadtest.header":   "operation\tsucceeded\terrors\ttimeouts\tp50\tp95\tp99\tmax\t",
+}
@@ -0,0 +1,126 @@
+package i18n
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+)
+
+// Supported languages, DefaultLang is used for unknown ones
+const (
+	LangRu      string = "ru"
+	LangEn      string = "en"
+	DefaultLang string = LangRu
+)
+
+var catalogs = map[string]map[string]string{
+	LangRu: ru,
+	LangEn: en,
+}
+
+// Message is a text sent by server to clients, each client renders it in its own language
+type Message struct {
+	Key  string   `json:"key"`
+	Args []string `json:"args,omitempty"`
+}
+
+func Msg(key string, args ...any) Message {
+	m := Message{Key: key}
+	for _, a := range args {
+		m.Args = append(m.Args, fmt.Sprint(a))
+	}
+	return m
+}
+
+func (m Message) Encode() string {
+	data, _ := json.Marshal(m)
+	return string(data)
+}
+
+func Decode(s string) (Message, error) {
+	m := Message{}
+	err := json.Unmarshal([]byte(s), &m)
+	if err == nil && m.Key == "" {
+		err = fmt.Errorf("message without key")
+	}
+	return m, err
+}
+
+type Printer struct {
+	lang string
+}
+
+// CreatePrinter falls back to DefaultLang for unsupported languages
+func CreatePrinter(lang string) *Printer {
+	lang = ParseLang(lang)
+	if _, ok := catalogs[lang]; !ok {
+		lang = DefaultLang
+	}
+	return &Printer{lang: lang}
+}
+
+func (p *Printer) Lang() string {
+	return p.lang
+}
+
+// T renders message by key. Missing keys are looked up in DefaultLang,
+// unknown keys are printed as is, so servers may send plain text too.
+func (p *Printer) T(key string, args ...any) string {
+	format, ok := catalogs[p.lang][key]
+	if !ok {
+		format, ok = catalogs[DefaultLang][key]
+	}
+	if !ok {
+		return key
+	}
+	return fmt.Sprintf(format, args...)
+}
+
+func (p *Printer) Render(m Message) string {
+	args := make([]any, 0, len(m.Args))
+	for _, a := range m.Args {
+		args = append(args, a)
+	}
+	return p.T(m.Key, args...)
+}
+
+// Catalog returns all messages of the language, missing ones are taken from DefaultLang.
+// It is used by clients which render messages themselves, e.g. web UI.
+func Catalog(lang string) map[string]string {
+	lang = CreatePrinter(lang).Lang()
+	res := make(map[string]string)
+	for key, format := range catalogs[DefaultLang] {
+		res[key] = format
+	}
+	for key, format := range catalogs[lang] {
+		res[key] = format
+	}
+	return res
+}
+
+// ParseLang turns locale like "en_US.UTF-8" into language code
+func ParseLang(locale string) string {
+	lang, _, _ := strings.Cut(locale, ".")
+	lang, _, _ = strings.Cut(lang, "_")
+	lang, _, _ = strings.Cut(lang, "-")
+	return strings.ToLower(lang)
+}
+
+// UserLang returns language chosen by MAFIA_LANG, otherwise language of the user's locale
+func UserLang() string {
+	if lang := os.Getenv("MAFIA_LANG"); lang != "" {
+		return lang
+	}
+	return EnvLang()
+}
+
+// EnvLang returns language of the user's locale, empty if it is not set
+func EnvLang() string {
+	for _, key := range []string{"LC_ALL", "LC_MESSAGES", "LANG"} {
+		if v := os.Getenv(key); v != "" && v != "C" && v != "POSIX" {
+			return ParseLang(v)
+		}
+	}
+	return ""
+}
@@ -0,0 +1,228 @@
+package i18n
+
+var ru = map[string]string{
+	// Chat messages sent by server
+	"chat.message":            "[%v] %v",
+	"chat.joined":             "Игрок %v успешно присоединился к лобби!",
+	"chat.left":               "Игрок %v отключился!",
+	"chat.lobby_full":         "Лобби заполнено...",
+	"chat.lobby_shutdown":     "Сервер завершает работу, новые игры не начнутся!",
+	"chat.kicked_you_lobby":   "Вы были исключены из лобби администратором!",
+	"chat.kicked_lobby":       "Игрок %v исключён из лобби!",
+	"chat.kicked_you_game":    "Вы были исключены из игры администратором!",
+	"chat.kicked_game":        "Игрок %v исключён из игры!",
+	"chat.announce":           "%v",
+	"chat.paused":             "Игра приостановлена администратором!",
+	"chat.resumed":            "Игра продолжается!",
+	"chat.restored":           "Игра восстановлена после перезапуска сервера!",
+	"chat.restored_paused":    "Игра восстановлена после перезапуска сервера и продолжится, когда её возобновит администратор!",
+	"chat.day":                "Новый день - новое голосование!",
+	"chat.night":              "Город засыпает...",
+	"chat.voted":              "Игрок #%v проголосовал против игрока #%v!",
+	"chat.vote_skipped":       "Игрок #%v решил не голосовать!",
+	"chat.nominated":          "Игрок #%v выдвинул игрока #%v!",
+	"chat.nomination_skipped": "Игрок #%v никого не выдвигает!",
+	"chat.voting":             "Голосование! Кандидаты: %v",
+	"chat.vote_result":        "Голосов за игрока #%v: %v",
+	"chat.no_jail":            "Сегодня виновных не нашлось, попробуем завтра...",
+	"chat.no_kill":            "Этой ночью никто не погиб!",
+	"chat.last_words":         "Последнее слово: %v. Остальные хранят молчание...",
+	"chat.stopped_shutdown":   "Игра остановлена, сервер завершает работу!",
+	"chat.saved_shutdown":     "Сервер завершает работу, игра сохранена и продолжится после перезапуска!",
+	"chat.stopped_admin":      "Игра остановлена администратором!",
+	"chat.meeting":            "Город спит, мафия собирается и строит планы...",
+	"chat.foul":               "Игрок #%v получает фол (%v из %v)!",
+	"chat.removed":            "Игрок #%v удалён из игры за фолы!",
+	"chat.best_move":          "Лучший ход игрока #%v: %v",
+	"chat.best_move_result":   "Игрок #%v угадал мафию в лучшем ходе: %v",
+
+	// Roles, teams and actions
+	"team.civ":           "Мирные жители",
+	"team.maf":           "Мафия",
+	"role.civ":           "Мирный житель",
+	"role.civ.hint":      "Полная луна за окном навевает тревогу...",
+	"role.com":           "Комиссар",
+	"role.com.hint":      "Настало время для поиска мафии! Используйте команду !check для проверки игрока",
+	"role.maf":           "Мафия",
+	"role.maf.hint":      "Настало время для поиска жертвы! Используйте команду !kill для убийства игрока",
+	"role.don":           "Дон",
+	"role.don.hint":      "Семья ждёт ваших приказов! Используйте команду !kill для убийства игрока и !seek для поиска комиссара",
+	"action.vote":        "Проголосовать за посадку игрока",
+	"action.nominate":    "Выдвинуть игрока на голосование",
+	"action.check":       "Узнать роль игрока",
+	"action.check.done":  "Вы подняли архивные документы, к утру всё станет ясно...",
+	"action.kill":        "Убить игрока",
+	"action.kill.done":   "Кажется, ваша жертва не доживёт до утра...",
+	"action.pass":        "Закончить последнее слово",
+	"action.seek":        "Узнать, комиссар ли игрок",
+	"action.seek.done":   "Ваши люди следят за игроком, к утру всё станет ясно...",
+	"action.end_meeting": "Закончить встречу",
+	"action.end_speech":  "Закончить речь",
+	"action.best_move":   "Назвать подозреваемых игроков",
+
+	// Errors by ErrorReason
+	"error.bad_request":    "некорректный запрос",
+	"error.unknown_player": "игрок не найден",
+	"error.dead_player":    "мёртвые не могут действовать",
+	"error.wrong_role":     "ваша роль не позволяет это сделать",
+	"error.wrong_phase":    "сейчас нельзя это сделать",
+	"error.unknown_action": "неизвестное действие",
+	"error.wrong_target":   "нельзя выбрать этого игрока",
+	"error.target_dead":    "этот игрок уже мёртв",
+	"error.already_acted":  "вы уже сделали свой ход",
+	"error.game_paused":    "игра приостановлена администратором",
+	"error.game_over":      "игра уже окончена",
+	"error.name_taken":     "игрок с таким именем уже существует",
+	"error.banned":         "игрок с таким именем заблокирован",
+	"error.shutting_down":  "сервер завершает работу",
+	"error.too_slow":       "слишком много непрочитанных событий",
+	"error.not_your_turn":  "сейчас не ваша очередь",
+	"error.unknown_game":   "игра %v не найдена",
+	"error.bad_token":      "неверный токен администратора",
+
+	// Lobby client
+	"lobby.welcome":       "~ Добро пожаловать в консольное приложение Мафия! ~",
+	"lobby.ask_name":      "Введите своё имя:",
+	"lobby.hello":         "Здравствуй, %v!",
+	"lobby.connecting":    "Подключаюсь к лобби...",
+	"lobby.connected":     "Подключение произошло успешно!",
+	"lobby.join_failed":   "Не удалось войти в лобби: %v",
+	"lobby.players_count": "Игроков в лобби: [%v/4]",
+	"lobby.shutdown":      "Сервер завершает работу, до встречи!",
+
+	// Game client
+	"game.press_enter":     "Введите любую строку для продолжения...",
+	"game.prompt":          "Введите команду или сообщение в чат:",
+	"game.bad_command":     "Неправильная команда! Введите !help для списка команд",
+	"game.send_failed":     "Не удалось отправить сообщение: %v",
+	"game.start":           "Игра начинается!",
+	"game.your_role":       "Ваша роль: ",
+	"game.help_hint":       "Для списка доступных команд введите !help",
+	"game.dead_chat":       "Дуновение ветра с кладбища напомнило прохожему о вас...",
+	"game.night_chat":      "Вы попытались нарушить ночную тишину, но никто вас не услышал...",
+	"game.connection_lost": "Соединение с сервером потеряно, переподключаемся...",
+	"game.reconnected":     "Соединение восстановлено!",
+	"game.killed":          "Тело игрока %v утром было найдено в канаве...",
+	"game.jailed":          "Игрок %v вздёрнут на площади!",
+	"game.vote_turn":       "Голосует игрок %v",
+	"game.your_vote_turn":  "Ваша очередь голосовать!",
+	"game.speech_turn":     "Говорит игрок %v",
+	"game.your_speech":     "Ваша речь! Остальные молчат",
+	"game.seek_found":      "Игрок #%v - комиссар!",
+	"game.seek_missed":     "Игрок #%v - не комиссар",
+	"game.over":            "Игра окончена!",
+	"game.winner":          "Победили: %v!",
+	"game.no_winner":       "Игра остановлена без победителя!",
+	"game.roles":           "Распределение по ролям:",
+	"game.shutdown":        "Сервер завершает работу!",
+	"game.shutdown_drain":  "Сервер завершает работу! У вас есть %v секунд, чтобы закончить игру...",
+	"game.check_result":    "Прошерстив архивные документы, вы выяснили, что роль игрока #%v - ",
+	"game.you_dead":        "Вы мертвы! :(",
+	"game.players_count":   "Игроков в игре: [%v/4]",
+	"game.alive":           "Оставшиеся игроки:",
+
+	// Commands
+	"cmd.list_title":    "Список команд:",
+	"cmd.help":          "Вывести список команд",
+	"cmd.list":          "Вывести список всех игроков",
+	"cmd.exit":          "Выйти из игры",
+	"cmd.role":          "Напомнить свою роль",
+	"cmd.alive":         "Вывести список живых игроков",
+	"cmd.state":         "Вывести состояние игры",
+	"cmd.can_skip":      ", pid = 0 означает пропуск",
+	"cmd.max_targets":   ", до %v игроков",
+	"cmd.few_args":      "Слишком мало аргументов для команды %v!",
+	"cmd.bad_pid":       "Неправильный номер игрока: %v",
+	"cmd.role_failed":   "Не удалось узнать роль: %v",
+	"cmd.action_failed": "Не удалось выполнить действие: %v",
+	"cmd.alive_failed":  "Произошла ошибка при получении списка живых игроков: %v",
+	"cmd.state_failed":  "Произошла ошибка при получении состояния игры: %v",
+
+	// Game state
+	"state.ended":        "Игра окончена.",
+	"state.day":          "День %v.",
+	"state.night":        "Ночь %v.",
+	"state.last_words":   "Последнее слово, день %v.",
+	"state.voting":       "Голосование, день %v.",
+	"state.meeting":      "Встреча мафии.",
+	"state.paused":       "Игра приостановлена администратором.",
+	"state.players":      "Игроки:",
+	"state.you":          " (вы)",
+	"state.dead":         " - мёртв",
+	"state.disconnected": " - отключился",
+	"state.nominee":      " - выдвинут",
+	"state.voter":        " - голосует",
+	"state.speaker":      " - говорит",
+	"state.fouls":        ", фолов: %v",
+	"state.no_vote":      ", не голосует",
+	"state.vote":         ", голос против #%v",
+	"state.check":        "Проверка в ночь %v: игрок #%v - ",
+	"state.seek_found":   "Поиск в ночь %v: игрок #%v - комиссар",
+	"state.seek_missed":  "Поиск в ночь %v: игрок #%v - не комиссар",
+
+	// Full-screen client
+	"tui.title":       "Мафия",
+	"tui.chat":        "Чат",
+	"tui.log":         "События",
+	"tui.lobby":       "лобби",
+	"tui.name_prompt": "Имя: ",
+	"tui.hint":        "!help - список команд, ↑/↓ - история ввода, Ctrl-C - выход",
+	"tui.day":         "день %v",
+	"tui.night":       "ночь %v",
+	"tui.last_words":  "последнее слово",
+	"tui.voting":      "голосование",
+	"tui.meeting":     "встреча мафии",
+	"tui.left":        "осталось %v",
+	"tui.paused":      "пауза",
+	"tui.dead":        "вы мертвы",
+	"tui.ended":       "игра окончена",
+	"tui.actions":     "Действия:",
+	"tui.game_over":   "Введите !exit для выхода",
+
+	// Web client
+	"web.join":    "Войти",
+	"web.send":    "Отправить",
+	"web.members": "В лобби:",
+	"web.waiting": "Ждём игроков...",
+	"web.skip":    "Пропустить",
+	"web.again":   "Сыграть ещё",
+	"web.closed":  "Соединение с сервером закрыто",
+
+	// Admin CLI
+	"admin.usage": "Команды:\n" +
+		"  list                 - список лобби и активных игр\n" +
+		"  inspect <game_id>    - полное состояние игры\n" +
+		"  end <game_id>        - завершить игру\n" +
+		"  pause <game_id>      - приостановить игру\n" +
+		"  resume <game_id>     - продолжить игру\n" +
+		"  kick <name>          - исключить игрока\n" +
+		"  ban <name>           - исключить и заблокировать игрока\n" +
+		"  foul <name>          - дать игроку фол (спортивные правила)\n" +
+		"  announce <msg...>    - разослать объявление всем игрокам\n",
+	"admin.error":           "Ошибка: %v",
+	"admin.no_command":      "не задана команда",
+	"admin.unknown_command": "неизвестная команда %v",
+	"admin.need_game_id":    "%v: нужен id игры",
+	"admin.need_name":       "%v: нужно имя игрока",
+	"admin.need_msg":        "%v: нужен текст сообщения",
+	"admin.lobby":           "Лобби #%v: [%v/%v] %v",
+	"admin.no_games":        "Активных игр нет",
+	"admin.game":            "Игра %v на %v%v: живых %v/%v, %v",
+	"admin.paused":          " (пауза)",
+	"admin.inspect":         "Игра %v, фаза: %v%v",
+	"admin.player":          "#%v. %v (%v) - %v, %v, голос: %v, голосов против: %v, фолов: %v",
+	"admin.alive":           "жив",
+	"admin.dead":            "мёртв",
+	"admin.vote_skipped":    "пропуск",
+
+	// Simulator and load test reports
+	"simulate.games":    "Сыграно игр: %v",
+	"simulate.wins":     "Победы:",
+	"simulate.town":     "мирные",
+	"simulate.mafia":    "мафия",
+	"simulate.draw":     "ничья",
+	"simulate.length":   "Средняя длина игры: %.2f дн., %.1f действий",
+	"simulate.survived": "Доживают до конца игры:",
+	"loadtest.summary":  "Длительность: %v, клиентов доиграло: %v, с ошибкой: %v, окончаний игр получено: %v",
+	"loadtest.header":   "операция\tуспешно\tошибки\tтаймауты\tp50\tp95\tp99\tmax\t",
+}
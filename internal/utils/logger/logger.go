@@ -0,0 +1,98 @@
+package logger
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/GandarfHSE/go-mafia/internal/proto"
+	"github.com/GandarfHSE/go-mafia/internal/utils/grpcutil"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+)
+
+// Common log fields, use them instead of ad-hoc keys so logs can be filtered
+const (
+	GameID = "game_id"
+	Phase  = "phase"
+	Day    = "day"
+	Player = "player"
+	Method = "method"
+	Error  = "error"
+)
+
+// Setup installs default slog logger with given level (debug, info, warn, error) and format (text, json)
+func Setup(level string, format string) error {
+	var lvl slog.Level
+	if err := lvl.UnmarshalText([]byte(level)); err != nil {
+		return fmt.Errorf("unknown log level %v", level)
+	}
+
+	opts := &slog.HandlerOptions{Level: lvl}
+	var handler slog.Handler
+	switch strings.ToLower(format) {
+	case "text":
+		handler = slog.NewTextHandler(os.Stderr, opts)
+	case "json":
+		handler = slog.NewJSONHandler(os.Stderr, opts)
+	default:
+		return fmt.Errorf("unknown log format %v", format)
+	}
+
+	slog.SetDefault(slog.New(handler))
+	return nil
+}
+
+// attrs are added to every RPC log line, e.g. game id for game servers
+func UnaryServerInterceptor(attrs ...any) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		start := time.Now()
+		resp, err := handler(ctx, req)
+		if r, ok := req.(interface{ GetPlayer() *proto.Player }); ok && r.GetPlayer() != nil {
+			logRPC(ctx, info.FullMethod, start, err, append([]any{Player, r.GetPlayer().Name}, attrs...))
+		} else {
+			logRPC(ctx, info.FullMethod, start, err, attrs)
+		}
+		return resp, err
+	}
+}
+
+func StreamServerInterceptor(attrs ...any) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		start := time.Now()
+		err := handler(srv, ss)
+		logRPC(ss.Context(), info.FullMethod, start, err, attrs)
+		return err
+	}
+}
+
+func ServerOptions(attrs ...any) []grpc.ServerOption {
+	return []grpc.ServerOption{
+		grpc.ChainUnaryInterceptor(UnaryServerInterceptor(attrs...)),
+		grpc.ChainStreamInterceptor(StreamServerInterceptor(attrs...)),
+	}
+}
+
+func logRPC(ctx context.Context, method string, start time.Time, err error, attrs []any) {
+	level := slog.LevelDebug
+	// Rejection with reason is a normal answer, e.g. to lobby chat of a player who has just moved to a game
+	reason := grpcutil.Reason(err)
+	if err != nil && reason == proto.ErrorReason_ERROR_REASON_UNSPECIFIED {
+		level = slog.LevelWarn
+	}
+	attrs = append([]any{
+		Method, method,
+		"code", status.Code(err).String(),
+		"duration", time.Since(start),
+	}, attrs...)
+	if err != nil {
+		attrs = append(attrs, Error, err)
+	}
+	if reason != proto.ErrorReason_ERROR_REASON_UNSPECIFIED {
+		attrs = append(attrs, "reason", reason.String())
+	}
+	slog.Log(ctx, level, "RPC handled", attrs...)
+}
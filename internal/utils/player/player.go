@@ -1,27 +1,74 @@
 package player
 
 import (
+	"context"
 	"fmt"
+	"log/slog"
 	"net"
+	"strings"
 
-	"github.com/GandarfHSE/go-mafia/internal/proto"
+	"github.com/GandarfHSE/go-mafia/internal/utils/fanout"
+	"github.com/GandarfHSE/go-mafia/internal/utils/i18n"
+	"github.com/GandarfHSE/go-mafia/internal/utils/logger"
 )
 
+// ServerSender is a sender of chat messages which don't come from players
+const ServerSender string = "server"
+
 type Player struct {
 	Name string
 	Addr string
 	Conn net.Conn
 
-	Role  string
-	Alive bool
+	chat *fanout.Queue[string]
+}
+
+// CreatePlayer starts chat delivery to conn. Messages are queued,
+// so slow or unreachable player doesn't delay messages to others.
+func CreatePlayer(name string, addr string, conn net.Conn, chatOpts fanout.Options) Player {
+	p := Player{
+		Name: name,
+		Addr: addr,
+		Conn: conn,
+		chat: fanout.CreateQueue[string](chatOpts, nil),
+	}
+	go p.deliverChat()
+	return p
+}
+
+// SendMsg queues message from sender, which is addr of a player or ServerSender
+func (p Player) SendMsg(from string, m i18n.Message) {
+	p.chat.Push(FormatChat(from, m))
+}
+
+// Close delivers already queued messages and closes connection
+func (p Player) Close() {
+	p.chat.Close()
+}
+
+func (p Player) deliverChat() {
+	defer p.Conn.Close()
+	for {
+		msg, err := p.chat.Pop(context.Background())
+		if err != nil {
+			return
+		}
+		if _, err := fmt.Fprint(p.Conn, msg); err != nil {
+			slog.Warn("Can't send chat message", logger.Player, p.Name, logger.Error, err)
+		}
+	}
+}
 
-	GameEventChan chan *proto.GameEvent
+// Chat line is "<sender>##<encoded message>", clients skip their own messages by sender
+func FormatChat(from string, m i18n.Message) string {
+	return from + "##" + m.Encode()
 }
 
-// [TODO] make version with color
-func (p Player) SendMsg(msg string) {
-	_, err := fmt.Fprint(p.Conn, msg)
-	if err != nil {
-		fmt.Printf("Can't send msg to %v, err: %v", p.Name, err)
+func ParseChat(line string) (string, i18n.Message, error) {
+	from, data, found := strings.Cut(line, "##")
+	if !found {
+		return "", i18n.Message{}, fmt.Errorf("no sender in chat message")
 	}
+	m, err := i18n.Decode(data)
+	return from, m, err
 }
@@ -0,0 +1,22 @@
+//go:build !(linux || darwin || freebsd || netbsd || openbsd)
+
+package terminal
+
+import (
+	"errors"
+	"os"
+)
+
+var errUnsupported = errors.New("terminal raw mode is not supported on this platform")
+
+func MakeRaw(fd int) (func(), error) {
+	return nil, errUnsupported
+}
+
+func Size(fd int) (int, int, error) {
+	return 0, 0, errUnsupported
+}
+
+// NotifyResize does nothing, size is read on every redraw
+func NotifyResize(ch chan<- os.Signal) {
+}